@@ -3,6 +3,7 @@ package merror
 import (
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontfamily"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontstyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
 	"github.com/miguelbernadi/maroto/v2/pkg/props"
 )
 
@@ -17,3 +18,12 @@ var DefaultErrorText = &props.Text{
 		Blue:  0,
 	},
 }
+
+// GetHeight returns the height of the error text added in the cell of a component that failed.
+func GetHeight(provider core.Provider) float64 {
+	return provider.GetTextHeight(&props.Font{
+		Family: DefaultErrorText.Family,
+		Style:  DefaultErrorText.Style,
+		Size:   DefaultErrorText.Size,
+	})
+}
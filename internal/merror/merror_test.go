@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/miguelbernadi/maroto/v2/internal/merror"
+	"github.com/miguelbernadi/maroto/v2/mocks"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
 
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontfamily"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontstyle"
@@ -19,3 +21,19 @@ func TestDefaultErrorText(t *testing.T) {
 	assert.Equal(t, 0, merror.DefaultErrorText.Color.Green)
 	assert.Equal(t, 0, merror.DefaultErrorText.Color.Blue)
 }
+
+func TestGetHeight(t *testing.T) {
+	// Arrange
+	provider := &mocks.Provider{}
+	provider.EXPECT().GetTextHeight(&props.Font{
+		Family: fontfamily.Arial,
+		Style:  fontstyle.Bold,
+		Size:   10,
+	}).Return(4.0)
+
+	// Act
+	height := merror.GetHeight(provider)
+
+	// Assert
+	assert.Equal(t, 4.0, height)
+}
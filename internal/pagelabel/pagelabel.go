@@ -0,0 +1,78 @@
+// Package pagelabel implements the page labels of PDFs, which are the page numbers shown by viewers.
+package pagelabel
+
+import (
+	"bytes"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+)
+
+// Label is the numbering of the pages from the page with the index Start,
+// counted from 0, until the page of the next label.
+type Label struct {
+	Start     int
+	Numbering numbering.Type
+}
+
+var styles = map[numbering.Type]string{
+	numbering.Decimal:     "D",
+	numbering.LowerRoman:  "r",
+	numbering.UpperRoman:  "R",
+	numbering.LowerLetter: "a",
+	numbering.UpperLetter: "A",
+}
+
+// Write replaces the page labels of a PDF, where the pages of each label are numbered
+// from 1. The protection is needed to read a PDF protected by passwords.
+func Write(pdf []byte, labels []Label, protection *entity.Protection) ([]byte, error) {
+	conf := api.LoadConfiguration()
+	conf.WriteXRefStream = false
+	if protection != nil {
+		conf.UserPW = protection.UserPassword
+		conf.OwnerPW = protection.OwnerPassword
+	}
+
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), conf)
+	if err != nil {
+		return nil, err
+	}
+
+	rootDict, err := ctx.Catalog()
+	if err != nil {
+		return nil, err
+	}
+
+	labelsRef, err := ctx.IndRefForNewObject(types.Dict{"Nums": getNums(labels)})
+	if err != nil {
+		return nil, err
+	}
+
+	rootDict["PageLabels"] = *labelsRef
+
+	var buf bytes.Buffer
+	if err = api.WriteContext(ctx, &buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// getNums returns the number tree of the labels, where each index of a page
+// is followed by the style of the numbering from it.
+func getNums(labels []Label) types.Array {
+	nums := types.Array{}
+	for _, label := range labels {
+		style, ok := styles[label.Numbering]
+		if !ok {
+			style = styles[numbering.Decimal]
+		}
+
+		nums = append(nums, types.Integer(label.Start), types.Dict{"S": types.Name(style)})
+	}
+
+	return nums
+}
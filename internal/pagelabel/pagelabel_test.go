@@ -0,0 +1,52 @@
+package pagelabel_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2"
+	"github.com/miguelbernadi/maroto/v2/internal/pagelabel"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/text"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+)
+
+func TestWrite(t *testing.T) {
+	// Arrange
+	m := maroto.New()
+	for i := 0; i < 60; i++ {
+		m.AddRows(text.NewRow(10, "text"))
+	}
+	doc, _ := m.Generate()
+
+	labels := []pagelabel.Label{
+		{Start: 0, Numbering: numbering.LowerRoman},
+		{Start: 1, Numbering: numbering.Decimal},
+		{Start: 2, Numbering: numbering.UpperLetter},
+	}
+
+	// Act
+	pdf, err := pagelabel.Write(doc.GetBytes(), labels, nil)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Nil(t, api.Validate(bytes.NewReader(pdf), api.LoadConfiguration()))
+
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), api.LoadConfiguration())
+	assert.Nil(t, err)
+	rootDict, err := ctx.Catalog()
+	assert.Nil(t, err)
+	labelsDict, err := ctx.DereferenceDict(rootDict["PageLabels"])
+	assert.Nil(t, err)
+	assert.Equal(t, types.Array{
+		types.Integer(0),
+		types.Dict{"S": types.Name("r")},
+		types.Integer(1),
+		types.Dict{"S": types.Name("D")},
+		types.Integer(2),
+		types.Dict{"S": types.Name("A")},
+	}, labelsDict.ArrayEntry("Nums"))
+}
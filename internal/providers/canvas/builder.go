@@ -0,0 +1,64 @@
+package canvas
+
+import (
+	"github.com/jung-kurt/gofpdf"
+
+	"github.com/miguelbernadi/maroto/v2/internal/cache"
+	"github.com/miguelbernadi/maroto/v2/internal/code"
+	"github.com/miguelbernadi/maroto/v2/internal/math"
+	pdf "github.com/miguelbernadi/maroto/v2/internal/providers/gofpdf"
+	"github.com/miguelbernadi/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+)
+
+// Dependencies is the dependencies provider for canvas
+type Dependencies struct {
+	Font   core.Font
+	Text   core.Text
+	Code   core.Code
+	Math   core.Math
+	Cache  cache.Cache
+	Canvas Canvas
+	Cfg    *entity.Config
+}
+
+type builder struct{}
+
+// NewBuilder create a new builder of Dependencies
+func NewBuilder() *builder {
+	return &builder{}
+}
+
+// Build create a new Dependencies. The texts are measured by gofpdf, so
+// the lines are broken in the same places of the PDF.
+func (b *builder) Build(cfg *entity.Config, cache cache.Cache, canvas Canvas) *Dependencies {
+	fpdf := gofpdfwrapper.NewCustom(&gofpdf.InitType{
+		OrientationStr: "P",
+		UnitStr:        "mm",
+		FontDirStr:     "",
+		Size: gofpdf.SizeType{
+			Wd: cfg.Dimensions.Width,
+			Ht: cfg.Dimensions.Height,
+		},
+	})
+
+	for _, font := range cfg.CustomFonts {
+		fpdf.AddUTF8FontFromBytes(font.Family, string(font.Style), font.Bytes)
+	}
+
+	fpdf.AddPage()
+
+	font := pdf.NewFont(fpdf, cfg.DefaultFont.Size, cfg.DefaultFont.Family, cfg.DefaultFont.Style)
+	math := math.New()
+
+	return &Dependencies{
+		Font:   font,
+		Text:   pdf.NewText(fpdf, math, font, pdf.NewLink(fpdf)),
+		Code:   code.New(),
+		Math:   math,
+		Cache:  cache,
+		Canvas: canvas,
+		Cfg:    cfg,
+	}
+}
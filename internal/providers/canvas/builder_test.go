@@ -0,0 +1,51 @@
+package canvas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/internal/fixture"
+	"github.com/miguelbernadi/maroto/v2/internal/providers/canvas"
+	"github.com/miguelbernadi/maroto/v2/mocks"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+)
+
+func TestNewBuilder(t *testing.T) {
+	// Act
+	sut := canvas.NewBuilder()
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*canvas.builder", fmt.Sprintf("%T", sut))
+}
+
+func TestBuilder_Build(t *testing.T) {
+	// Arrange
+	sut := canvas.NewBuilder()
+	font := fixture.FontProp()
+	cfg := &entity.Config{
+		Dimensions: &entity.Dimensions{
+			Width:  100,
+			Height: 200,
+		},
+		Margins: &entity.Margins{
+			Left:   10,
+			Top:    10,
+			Right:  10,
+			Bottom: 10,
+		},
+		DefaultFont: &font,
+	}
+
+	canvasMock := mocks.NewCanvas(t)
+
+	// Act
+	dep := sut.Build(cfg, nil, canvasMock)
+
+	// Assert
+	assert.NotNil(t, dep)
+	assert.Equal(t, cfg, dep.Cfg)
+	assert.Equal(t, canvasMock, dep.Canvas)
+}
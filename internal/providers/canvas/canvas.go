@@ -0,0 +1,23 @@
+package canvas
+
+import (
+	"io"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+// Canvas is the abstraction of a document drawn by the canvas provider, where
+// the coordinates are millimeters from the top left corner of the page.
+type Canvas interface {
+	AddPage(width, height float64)
+	AddRect(x, y, width, height float64, color *props.Color)
+	AddLine(x1, y1, x2, y2 float64, prop *props.Line)
+	AddText(x, y float64, text string, fontSize float64, prop *props.Text, link string)
+	AddImage(x, y, width, height float64, img *entity.Image) error
+	AddDestination(x, y float64, name string)
+	BeginTransform(x, y, angle, opacity float64)
+	EndTransform()
+	SetMetadata(metadata *entity.Metadata)
+	Write(w io.Writer) error
+}
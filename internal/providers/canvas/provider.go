@@ -0,0 +1,434 @@
+// Package canvas implements a provider which lays out the components as gofpdf
+// does and draws them in a Canvas, which defines the format of the document.
+package canvas
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/miguelbernadi/maroto/v2/internal/cache"
+	"github.com/miguelbernadi/maroto/v2/internal/merror"
+	pdf "github.com/miguelbernadi/maroto/v2/internal/providers/gofpdf"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/barcode"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/border"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/linestyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/orientation"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+type provider struct {
+	font   core.Font
+	text   core.Text
+	code   core.Code
+	math   core.Math
+	cache  cache.Cache
+	canvas Canvas
+	cfg    *entity.Config
+	// x and y are the position of the next col inside the margins.
+	x float64
+	y float64
+	// hasPage is true when a page was added.
+	hasPage bool
+	// errors are the failures of components collected in strict mode.
+	errors core.ComponentErrors
+}
+
+// New is the constructor of provider for canvas
+func New(dep *Dependencies) core.Provider {
+	return &provider{
+		font:   dep.Font,
+		text:   dep.Text,
+		code:   dep.Code,
+		math:   dep.Math,
+		cache:  dep.Cache,
+		canvas: dep.Canvas,
+		cfg:    dep.Cfg,
+	}
+}
+
+// AddPage starts a new page with the width and height, which may
+// differ from the dimensions of the document.
+func (g *provider) AddPage(width, height float64) {
+	g.hasPage = true
+	g.canvas.AddPage(width, height)
+	g.x, g.y = 0, 0
+}
+
+func (g *provider) CreateRow(height float64) {
+	g.y += height
+	g.x = 0
+}
+
+// SetPosition moves the position of the next col to x and y,
+// which are relative to the left and top margins.
+func (g *provider) SetPosition(x, y float64) {
+	g.x = x
+	g.y = y
+}
+
+func (g *provider) CreateCol(width, height float64, config *entity.Config, prop *props.Cell) {
+	// As in gofpdf, a page is started when no page was added.
+	if !g.hasPage {
+		g.AddPage(g.cfg.Dimensions.Width, g.cfg.Dimensions.Height)
+	}
+
+	debug := config != nil && config.Debug
+	g.addCell(g.cfg.Margins.Left+g.x, g.cfg.Margins.Top+g.y, width, height, prop, debug)
+	g.x += width
+}
+
+func (g *provider) AddLine(cell *entity.Cell, prop *props.Line) {
+	left, top := g.cfg.Margins.Left+cell.X, g.cfg.Margins.Top+cell.Y
+
+	if prop.Orientation == orientation.Vertical {
+		size := cell.Height * (prop.SizePercent / 100.0)
+		position := cell.Width * (prop.OffsetPercent / 100.0)
+		space := (cell.Height - size) / 2.0
+		g.canvas.AddLine(left+position, top+space, left+position, top+cell.Height-space, prop)
+		return
+	}
+
+	size := cell.Width * (prop.SizePercent / 100.0)
+	position := cell.Height * (prop.OffsetPercent / 100.0)
+	space := (cell.Width - size) / 2.0
+	g.canvas.AddLine(left+space, top+position, left+cell.Width-space, top+position, prop)
+}
+
+func (g *provider) AddText(text string, cell *entity.Cell, prop *props.Text) {
+	fontSize := g.font.GetHeight(prop.Family, prop.Style, prop.Size)
+
+	textProp := *prop
+	link := ""
+	if prop.Hyperlink != nil {
+		link = *prop.Hyperlink
+	}
+
+	if prop.InternalLink != nil {
+		link = "#" + *prop.InternalLink
+	}
+
+	if link != "" {
+		textProp.Color = &props.BlueColor
+	}
+
+	for _, line := range g.getTextLines(text, cell, prop) {
+		g.canvas.AddText(g.cfg.Margins.Left+line.x, g.cfg.Margins.Top+line.y, line.text, fontSize, &textProp, link)
+	}
+}
+
+func (g *provider) GetTextHeight(prop *props.Font) float64 {
+	return g.font.GetHeight(prop.Family, prop.Style, prop.Size)
+}
+
+func (g *provider) GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int {
+	return g.text.GetLinesQuantity(text, *textProp, colWidth)
+}
+
+func (g *provider) GetLines(text string, textProp *props.Text, colWidth float64) []string {
+	return g.text.GetLines(text, textProp, colWidth)
+}
+
+func (g *provider) GetStringWidth(text string, textProp *props.Text) float64 {
+	return g.text.GetStringWidth(text, textProp)
+}
+
+func (g *provider) AddMatrixCode(code string, cell *entity.Cell, prop *props.Rect) {
+	image, err := g.cache.GetImage(code, extension.Jpg)
+	if err != nil {
+		image, err = g.code.GenDataMatrix(code)
+	}
+	if err != nil {
+		g.addError(cell, "could not generate matrixcode", err)
+		return
+	}
+
+	g.cache.AddImage(code, image)
+	if err = g.addImage(image, cell, prop); err != nil {
+		g.addError(cell, "could not add matrixcode to document", err)
+	}
+}
+
+func (g *provider) AddQrCode(code string, cell *entity.Cell, prop *props.Rect) {
+	image, err := g.cache.GetImage(code, extension.Jpg)
+	if err != nil {
+		image, err = g.code.GenQr(code)
+	}
+	if err != nil {
+		g.addError(cell, "could not generate qrcode", err)
+		return
+	}
+
+	g.cache.AddImage(code, image)
+	if err = g.addImage(image, cell, prop); err != nil {
+		g.addError(cell, "could not add qrcode to document", err)
+	}
+}
+
+func (g *provider) AddBarCode(code string, cell *entity.Cell, prop *props.Barcode) {
+	image, err := g.cache.GetImage(g.getBarcodeImageName(code, prop), extension.Jpg)
+	if err != nil {
+		image, err = g.code.GenBar(code, cell, prop)
+	}
+	if err != nil {
+		g.addError(cell, "could not generate barcode", err)
+		return
+	}
+
+	g.cache.AddImage(g.getBarcodeImageName(code, prop), image)
+	if err = g.addImage(image, cell, prop.ToRectProp()); err != nil {
+		g.addError(cell, "could not add barcode to document", err)
+	}
+}
+
+func (g *provider) AddImageFromFile(file string, cell *entity.Cell, prop *props.Rect) {
+	extensionStr := strings.ToLower(strings.TrimPrefix(filepath.Ext(file), "."))
+	image, err := g.cache.GetImage(file, extension.Type(extensionStr))
+	if err != nil {
+		err = g.cache.LoadImage(file, extension.Type(extensionStr))
+		if err != nil {
+			g.addError(cell, "could not load image", err)
+			return
+		}
+
+		image, err = g.cache.GetImage(file, extension.Type(extensionStr))
+		if err != nil {
+			g.addError(cell, "could not load image", err)
+			return
+		}
+	}
+
+	g.AddImageFromBytes(image.Bytes, cell, prop, extension.Type(extensionStr))
+}
+
+func (g *provider) AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type) {
+	img, err := pdf.FromBytes(bytes, extension)
+	if err != nil {
+		g.addError(cell, "could not parse image bytes", err)
+		return
+	}
+
+	if err = g.addImage(img, cell, prop); err != nil {
+		g.addError(cell, "could not add image to document", err)
+	}
+}
+
+func (g *provider) AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type) {
+	img, err := pdf.FromBytes(bytes, extension)
+	if err != nil {
+		g.addError(cell, "could not parse image bytes", err)
+		return
+	}
+
+	if err = g.addImage(img, cell, prop); err != nil {
+		g.addError(cell, "could not add image to document", err)
+	}
+}
+
+func (g *provider) AddTextWatermark(text string, cell *entity.Cell, prop *props.Watermark) {
+	g.beginWatermark(cell, prop)
+	g.AddText(text, cell, prop.ToTextProp())
+	g.canvas.EndTransform()
+}
+
+func (g *provider) AddImageWatermark(bytes []byte, cell *entity.Cell, prop *props.Watermark, extension extension.Type) {
+	img, err := pdf.FromBytes(bytes, extension)
+	if err != nil {
+		g.addError(cell, "could not parse image bytes", err)
+		return
+	}
+
+	g.beginWatermark(cell, prop)
+	err = g.addImage(img, cell, &props.Rect{Center: true, Percent: prop.Percent})
+	g.canvas.EndTransform()
+	if err != nil {
+		g.addError(cell, "could not add image to document", err)
+	}
+}
+
+func (g *provider) GetDimensionsByImage(file string) (*entity.Dimensions, error) {
+	extensionStr := strings.ToLower(strings.TrimPrefix(filepath.Ext(file), "."))
+	image, err := g.cache.GetImage(file, extension.Type(extensionStr))
+	if err != nil {
+		err = g.cache.LoadImage(file, extension.Type(extensionStr))
+		if err != nil {
+			return nil, err
+		}
+
+		image, err = g.cache.GetImage(file, extension.Type(extensionStr))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pdf.GetDimensions(image)
+}
+
+func (g *provider) GetDimensionsByImageByte(bytes []byte, extension extension.Type) (*entity.Dimensions, error) {
+	img, err := pdf.FromBytes(bytes, extension)
+	if err != nil {
+		return nil, err
+	}
+
+	return pdf.GetDimensions(img)
+}
+
+func (g *provider) GetDimensionsByQrCode(code string) (*entity.Dimensions, error) {
+	image, err := g.cache.GetImage(code, extension.Jpg)
+	if err != nil {
+		image, err = g.code.GenQr(code)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	g.cache.AddImage(code, image)
+	return pdf.GetDimensions(image)
+}
+
+func (g *provider) GetDimensionsByMatrixCode(code string) (*entity.Dimensions, error) {
+	image, err := g.cache.GetImage(code, extension.Jpg)
+	if err != nil {
+		image, err = g.code.GenDataMatrix(code)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	g.cache.AddImage(code, image)
+	return pdf.GetDimensions(image)
+}
+
+// AddBookmark does nothing, as the canvas documents have no outline.
+func (g *provider) AddBookmark(string, int) {}
+
+// AddDestination defines the current position as the target of texts
+// with props.Text.InternalLink.
+func (g *provider) AddDestination(name string) {
+	g.canvas.AddDestination(g.cfg.Margins.Left, g.cfg.Margins.Top+g.y, name)
+}
+
+// SetProtection does nothing, as the canvas documents cannot be protected.
+func (g *provider) SetProtection(*entity.Protection) {}
+
+// SetCompression does nothing, as the canvas defines the compression of the document.
+func (g *provider) SetCompression(bool) {}
+
+func (g *provider) SetMetadata(metadata *entity.Metadata) {
+	if metadata == nil {
+		return
+	}
+
+	g.canvas.SetMetadata(metadata)
+}
+
+func (g *provider) GenerateBytes() ([]byte, error) {
+	var buffer bytes.Buffer
+	err := g.GenerateTo(&buffer)
+
+	return buffer.Bytes(), err
+}
+
+// GenerateTo writes the document drawn in the canvas to w. In strict mode,
+// the failures of components are returned instead of writing the document.
+func (g *provider) GenerateTo(w io.Writer) error {
+	if len(g.errors) > 0 {
+		return g.errors
+	}
+
+	return g.canvas.Write(w)
+}
+
+func (g *provider) addImage(img *entity.Image, cell *entity.Cell, prop *props.Rect) error {
+	dimensions, err := pdf.GetDimensions(img)
+	if err != nil {
+		return err
+	}
+
+	var rectCell *entity.Cell
+	if prop.Center {
+		rectCell = g.math.GetInnerCenterCell(dimensions, cell.GetDimensions(), prop.Percent)
+	} else {
+		rectCell = g.math.GetInnerNonCenterCell(dimensions, cell.GetDimensions(), prop)
+	}
+
+	return g.canvas.AddImage(g.cfg.Margins.Left+cell.X+rectCell.X, g.cfg.Margins.Top+cell.Y+rectCell.Y,
+		rectCell.Width, rectCell.Height, img)
+}
+
+// beginWatermark applies the opacity and the rotation, around the center of the cell,
+// to everything drawn until the transform ends.
+func (g *provider) beginWatermark(cell *entity.Cell, prop *props.Watermark) {
+	x := g.cfg.Margins.Left + cell.X + cell.Width/2
+	y := g.cfg.Margins.Top + cell.Y + cell.Height/2
+	g.canvas.BeginTransform(x, y, prop.Angle, prop.Opacity)
+}
+
+// addCell draws the background color and the borders of a cell.
+func (g *provider) addCell(x, y, width, height float64, prop *props.Cell, debug bool) {
+	if prop == nil {
+		prop = &props.Cell{}
+	}
+
+	if prop.BackgroundColor != nil {
+		g.canvas.AddRect(x, y, width, height, prop.BackgroundColor)
+	}
+
+	bd := prop.BorderType
+	if debug {
+		bd = border.Full
+	}
+
+	line := &props.Line{
+		Color:     prop.BorderColor,
+		Style:     prop.LineStyle,
+		Thickness: prop.BorderThickness,
+	}
+
+	if line.Thickness == 0 {
+		line.Thickness = linestyle.DefaultLineThickness
+	}
+
+	if bd == border.Full || bd == border.Top {
+		g.canvas.AddLine(x, y, x+width, y, line)
+	}
+
+	if bd == border.Full || bd == border.Bottom {
+		g.canvas.AddLine(x, y+height, x+width, y+height, line)
+	}
+
+	if bd == border.Full || bd == border.Left {
+		g.canvas.AddLine(x, y, x, y+height, line)
+	}
+
+	if bd == border.Full || bd == border.Right {
+		g.canvas.AddLine(x+width, y, x+width, y+height, line)
+	}
+}
+
+// addError adds an error text in the cell of a component that failed, or
+// collects the failure to be returned by GenerateTo in strict mode.
+func (g *provider) addError(cell *entity.Cell, message string, err error) {
+	if !g.cfg.StrictMode {
+		g.AddText(message, cell, merror.DefaultErrorText)
+		return
+	}
+
+	g.errors = append(g.errors, &core.ComponentError{
+		Path:    cell.Path,
+		Message: message,
+		Err:     err,
+	})
+}
+
+func (g *provider) getBarcodeImageName(code string, prop *props.Barcode) string {
+	if prop == nil {
+		return code + string(barcode.Code128)
+	}
+
+	return code + string(prop.Type)
+}
@@ -0,0 +1,476 @@
+package canvas_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/miguelbernadi/maroto/v2/internal/cache"
+	"github.com/miguelbernadi/maroto/v2/internal/fixture"
+	"github.com/miguelbernadi/maroto/v2/internal/merror"
+	"github.com/miguelbernadi/maroto/v2/internal/providers/canvas"
+	"github.com/miguelbernadi/maroto/v2/mocks"
+	"github.com/miguelbernadi/maroto/v2/pkg/config"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/linestyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/orientation"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+func newProvider(t *testing.T, cfg *entity.Config) (core.Provider, *mocks.Canvas) {
+	canvasMock := mocks.NewCanvas(t)
+	canvasMock.EXPECT().AddPage(cfg.Dimensions.Width, cfg.Dimensions.Height)
+
+	provider := canvas.New(canvas.NewBuilder().Build(cfg, cache.New(), canvasMock))
+	provider.AddPage(cfg.Dimensions.Width, cfg.Dimensions.Height)
+
+	return provider, canvasMock
+}
+
+func TestNew(t *testing.T) {
+	// Act
+	sut := canvas.New(canvas.NewBuilder().Build(config.NewBuilder().Build(), cache.New(), mocks.NewCanvas(t)))
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*canvas.provider", fmt.Sprintf("%T", sut))
+}
+
+func TestProvider_AddPage(t *testing.T) {
+	t.Run("when page is added, should add a page with its dimensions", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		canvasMock.EXPECT().AddPage(297.0, 210.0)
+
+		// Act
+		sut.AddPage(297, 210)
+
+		// Assert
+		canvasMock.AssertCalled(t, "AddPage", 297.0, 210.0)
+	})
+	t.Run("when no page was added, should start a page before the first col", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().Build()
+		canvasMock := mocks.NewCanvas(t)
+		canvasMock.EXPECT().AddPage(cfg.Dimensions.Width, cfg.Dimensions.Height)
+		sut := canvas.New(canvas.NewBuilder().Build(cfg, cache.New(), canvasMock))
+
+		// Act
+		sut.CreateCol(190, 100, nil, nil)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddPage", 1)
+	})
+}
+
+func TestProvider_CreateCol(t *testing.T) {
+	t.Run("when cell has style, should draw the background and the border", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		prop := fixture.CellProp()
+		line := &props.Line{Color: prop.BorderColor, Style: prop.LineStyle, Thickness: prop.BorderThickness}
+		canvasMock.EXPECT().AddRect(10.0, 10.0, 50.0, 20.0, prop.BackgroundColor)
+		canvasMock.EXPECT().AddLine(10.0, 10.0, 10.0, 30.0, line)
+
+		// Act
+		sut.CreateCol(50, 20, &entity.Config{}, &prop)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddRect", 1)
+		canvasMock.AssertNumberOfCalls(t, "AddLine", 1)
+	})
+	t.Run("when debug is enabled, should draw all borders", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		canvasMock.EXPECT().AddLine(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.CreateCol(50, 20, &entity.Config{Debug: true}, nil)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddLine", 4)
+	})
+	t.Run("when there is a previous col, should draw after it", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		prop := fixture.CellProp()
+		prop.BorderType = ""
+		canvasMock.EXPECT().AddRect(10.0, 10.0, 50.0, 20.0, prop.BackgroundColor)
+		canvasMock.EXPECT().AddRect(60.0, 10.0, 50.0, 20.0, prop.BackgroundColor)
+		sut.CreateCol(50, 20, nil, &prop)
+
+		// Act
+		sut.CreateCol(50, 20, nil, &prop)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddRect", 2)
+	})
+	t.Run("when col does not fit in the page, should not add a new page, as pages are added by maroto", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		sut.CreateCol(190, 200, nil, nil)
+		sut.CreateRow(200)
+
+		// Act
+		sut.CreateCol(190, 100, nil, nil)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddPage", 1)
+	})
+}
+
+func TestProvider_CreateRow(t *testing.T) {
+	// Arrange
+	sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+	color := &props.RedColor
+	canvasMock.EXPECT().AddRect(10.0, 10.0, 50.0, 20.0, color)
+	canvasMock.EXPECT().AddRect(10.0, 30.0, 50.0, 20.0, color)
+	sut.CreateCol(50, 20, nil, &props.Cell{BackgroundColor: color})
+
+	// Act
+	sut.CreateRow(20)
+
+	// Assert
+	sut.CreateCol(50, 20, nil, &props.Cell{BackgroundColor: color})
+	canvasMock.AssertNumberOfCalls(t, "AddRect", 2)
+}
+
+func TestProvider_SetPosition(t *testing.T) {
+	// Arrange
+	sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+	color := &props.RedColor
+	canvasMock.EXPECT().AddRect(40.0, 50.0, 50.0, 20.0, color)
+
+	// Act
+	sut.SetPosition(30, 40)
+
+	// Assert
+	sut.CreateCol(50, 20, nil, &props.Cell{BackgroundColor: color})
+	canvasMock.AssertNumberOfCalls(t, "AddRect", 1)
+}
+
+func TestProvider_AddText(t *testing.T) {
+	t.Run("when text fits in one line, should draw it in the baseline of the first line", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		prop := fixture.TextProp()
+		prop.Align = align.Left
+		prop.Hyperlink = nil
+		fontHeight := sut.GetTextHeight(&props.Font{Family: prop.Family, Style: prop.Style, Size: prop.Size})
+		canvasMock.EXPECT().AddText(23.0, 37.0+fontHeight, "text", fontHeight, &prop, "")
+
+		// Act
+		sut.AddText("text", &cell, &prop)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when text is aligned in the bottom, should draw it at the end of the cell", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		prop := fixture.TextProp()
+		prop.Align = align.Left
+		prop.VerticalAlign = align.Bottom
+		prop.Hyperlink = nil
+		fontHeight := sut.GetTextHeight(&props.Font{Family: prop.Family, Style: prop.Style, Size: prop.Size})
+		canvasMock.EXPECT().AddText(23.0, 175.0, "text", fontHeight, &prop, "")
+
+		// Act
+		sut.AddText("text", &cell, &prop)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when text does not fit in one line, should draw all lines", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		prop := fixture.TextProp()
+		text := strings.Repeat("long text ", 20)
+		canvasMock.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.AddText(text, &cell, &prop)
+
+		// Assert
+		lines := sut.GetLinesQuantity(text, &prop, cell.Width-prop.Left-prop.Right)
+		canvasMock.AssertNumberOfCalls(t, "AddText", lines)
+	})
+	t.Run("when text has an internal link, should draw it in blue with the link", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		destination := "summary"
+		prop := fixture.TextProp()
+		prop.Hyperlink = nil
+		prop.InternalLink = &destination
+		canvasMock.EXPECT().AddText(mock.Anything, mock.Anything, "text", mock.Anything, mock.Anything, "#summary").
+			Run(func(_, _ float64, _ string, _ float64, prop *props.Text, _ string) {
+				assert.Equal(t, &props.BlueColor, prop.Color)
+			})
+
+		// Act
+		sut.AddText("text", &cell, &prop)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddText", 1)
+	})
+}
+
+func TestProvider_AddLine(t *testing.T) {
+	t.Run("when line is vertical, should draw it in the offset of the cell", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		prop := fixture.LineProp()
+		prop.Orientation = orientation.Vertical
+		prop.OffsetPercent = 50
+		prop.SizePercent = 100
+		canvasMock.EXPECT().AddLine(70.0, 25.0, 70.0, 175.0, &prop)
+
+		// Act
+		sut.AddLine(&cell, &prop)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddLine", 1)
+	})
+	t.Run("when line is horizontal, should draw it in the offset of the cell", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		prop := fixture.LineProp()
+		prop.Orientation = orientation.Horizontal
+		prop.OffsetPercent = 50
+		prop.SizePercent = 100
+		prop.Style = linestyle.Solid
+		canvasMock.EXPECT().AddLine(20.0, 100.0, 120.0, 100.0, &prop)
+
+		// Act
+		sut.AddLine(&cell, &prop)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddLine", 1)
+	})
+}
+
+func TestProvider_AddImageFromBytes(t *testing.T) {
+	t.Run("when image is invalid, should add an error text", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		canvasMock.EXPECT().AddText(mock.Anything, mock.Anything, "could not add image to document", mock.Anything,
+			mock.Anything, "")
+
+		// Act
+		sut.AddImageFromBytes([]byte{1, 2, 3}, &cell, &props.Rect{}, extension.Png)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when canvas cannot draw the image, should add an error text", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		bytes, err := os.ReadFile("../../../docs/assets/images/logosmall.png")
+		assert.Nil(t, err)
+		prop := fixture.RectProp()
+		canvasMock.EXPECT().AddImage(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(errors.New("anyError"))
+		canvasMock.EXPECT().AddText(mock.Anything, mock.Anything, "could not add image to document", mock.Anything,
+			merror.DefaultErrorText, "")
+
+		// Act
+		sut.AddImageFromBytes(bytes, &cell, &prop, extension.Png)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddImage", 1)
+		canvasMock.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when image is valid, should draw it inside the cell", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		bytes, err := os.ReadFile("../../../docs/assets/images/logosmall.png")
+		assert.Nil(t, err)
+		prop := fixture.RectProp()
+		canvasMock.EXPECT().AddImage(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			&entity.Image{Bytes: bytes, Extension: extension.Png}).Return(nil)
+
+		// Act
+		sut.AddImageFromBytes(bytes, &cell, &prop, extension.Png)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddImage", 1)
+	})
+}
+
+func TestProvider_AddQrCode(t *testing.T) {
+	// Arrange
+	sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+	cell := fixture.CellEntity()
+	prop := fixture.RectProp()
+	canvasMock.EXPECT().AddImage(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// Act
+	sut.AddQrCode("code", &cell, &prop)
+
+	// Assert
+	canvasMock.AssertNumberOfCalls(t, "AddImage", 1)
+}
+
+func TestProvider_AddBackgroundImageFromBytes(t *testing.T) {
+	// Arrange
+	sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+	cell := entity.NewRootCell(210, 297, fixture.MarginsEntity())
+	bytes, err := os.ReadFile("../../../docs/assets/images/logosmall.png")
+	assert.Nil(t, err)
+	prop := fixture.RectProp()
+	canvasMock.EXPECT().AddImage(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// Act
+	sut.AddBackgroundImageFromBytes(bytes, &cell, &prop, extension.Png)
+
+	// Assert
+	canvasMock.AssertNumberOfCalls(t, "AddPage", 1)
+	canvasMock.AssertNumberOfCalls(t, "AddImage", 1)
+}
+
+func TestProvider_AddTextWatermark(t *testing.T) {
+	// Arrange
+	sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+	cell := fixture.CellEntity()
+	prop := &props.Watermark{Angle: 45, Opacity: 0.3, Size: 20}
+	var calls []string
+	canvasMock.EXPECT().BeginTransform(70.0, 100.0, 45.0, 0.3).Run(func(float64, float64, float64, float64) {
+		calls = append(calls, "begin")
+	})
+	canvasMock.EXPECT().AddText(mock.Anything, mock.Anything, "DRAFT", mock.Anything, mock.Anything, "").
+		Run(func(float64, float64, string, float64, *props.Text, string) {
+			calls = append(calls, "text")
+		})
+	canvasMock.EXPECT().EndTransform().Run(func() {
+		calls = append(calls, "end")
+	})
+
+	// Act
+	sut.AddTextWatermark("DRAFT", &cell, prop)
+
+	// Assert
+	assert.Equal(t, []string{"begin", "text", "end"}, calls)
+}
+
+func TestProvider_AddImageWatermark(t *testing.T) {
+	t.Run("when image is invalid, should add an error text", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		canvasMock.EXPECT().AddText(mock.Anything, mock.Anything, "could not parse image bytes", mock.Anything,
+			merror.DefaultErrorText, "")
+
+		// Act
+		sut.AddImageWatermark([]byte{1, 2, 3}, &cell, &props.Watermark{}, "invalid")
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddText", 1)
+		canvasMock.AssertNumberOfCalls(t, "BeginTransform", 0)
+	})
+	t.Run("when image is valid, should draw it in the center of the cell inside a transform", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		cell := fixture.CellEntity()
+		bytes, err := os.ReadFile("../../../docs/assets/images/logosmall.png")
+		assert.Nil(t, err)
+		canvasMock.EXPECT().BeginTransform(70.0, 100.0, 30.0, 0.5)
+		canvasMock.EXPECT().AddImage(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		canvasMock.EXPECT().EndTransform()
+
+		// Act
+		sut.AddImageWatermark(bytes, &cell, &props.Watermark{Angle: 30, Opacity: 0.5, Percent: 100}, extension.Png)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "AddImage", 1)
+		canvasMock.AssertNumberOfCalls(t, "EndTransform", 1)
+	})
+}
+
+func TestProvider_AddDestination(t *testing.T) {
+	// Arrange
+	sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+	canvasMock.EXPECT().AddDestination(10.0, 30.0, "summary")
+	sut.CreateRow(20)
+
+	// Act
+	sut.AddDestination("summary")
+
+	// Assert
+	canvasMock.AssertNumberOfCalls(t, "AddDestination", 1)
+}
+
+func TestProvider_SetMetadata(t *testing.T) {
+	t.Run("when metadata is nil, should not set it", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+
+		// Act
+		sut.SetMetadata(nil)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "SetMetadata", 0)
+	})
+	t.Run("when metadata is set, should set it in the canvas", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		metadata := &entity.Metadata{Title: &entity.Utf8Text{Text: "title"}}
+		canvasMock.EXPECT().SetMetadata(metadata)
+
+		// Act
+		sut.SetMetadata(metadata)
+
+		// Assert
+		canvasMock.AssertNumberOfCalls(t, "SetMetadata", 1)
+	})
+}
+
+func TestProvider_GenerateTo(t *testing.T) {
+	t.Run("when there is no error, should write the canvas", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().Build())
+		var buffer bytes.Buffer
+		canvasMock.EXPECT().Write(&buffer).Return(nil)
+
+		// Act
+		err := sut.GenerateTo(&buffer)
+
+		// Assert
+		assert.Nil(t, err)
+		canvasMock.AssertNumberOfCalls(t, "Write", 1)
+	})
+	t.Run("when a component failed in strict mode, should return the error", func(t *testing.T) {
+		// Arrange
+		sut, canvasMock := newProvider(t, config.NewBuilder().WithStrictMode(true).Build())
+		cell := fixture.CellEntity()
+		cell.Path = entity.Path{Page: 1, Row: 2, Col: 3}
+		sut.AddImageFromBytes([]byte{1, 2, 3}, &cell, &props.Rect{}, extension.Png)
+
+		// Act
+		err := sut.GenerateTo(&bytes.Buffer{})
+
+		// Assert
+		var componentErrors core.ComponentErrors
+		assert.True(t, errors.As(err, &componentErrors))
+		assert.Equal(t, cell.Path, componentErrors[0].Path)
+		assert.Equal(t, "could not add image to document", componentErrors[0].Message)
+		canvasMock.AssertNumberOfCalls(t, "Write", 0)
+	})
+}
@@ -0,0 +1,70 @@
+package canvas
+
+import (
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+// textLine is a line of a text placed inside a cell, where y is the baseline.
+type textLine struct {
+	text  string
+	x     float64
+	y     float64
+	width float64
+}
+
+// getTextLines places the lines of a text inside a cell, broken by the text of
+// the provider in the same places of the PDF. The lines keep the UTF-8
+// characters translated by gofpdf for the standard fonts.
+func (g *provider) getTextLines(text string, cell *entity.Cell, prop *props.Text) []textLine {
+	fontHeight := g.font.GetHeight(prop.Family, prop.Style, prop.Size)
+
+	top, left, right := prop.Top, prop.Left, prop.Right
+	if top > cell.Height {
+		top = cell.Height
+	}
+
+	if left > cell.Width {
+		left = cell.Width
+	}
+
+	if right > cell.Width {
+		right = cell.Width
+	}
+
+	width := cell.Width - left - right
+	if width < 0 {
+		width = 0
+	}
+
+	lines := g.text.GetLines(text, prop, width)
+
+	x := cell.X + left
+	y := cell.Y + top + fontHeight
+	y += prop.GetVerticalOffset(cell.Height, fontHeight, len(lines))
+
+	textLines := make([]textLine, 0, len(lines))
+	for i, line := range lines {
+		lineWidth := g.text.GetStringWidth(line, prop)
+		textLines = append(textLines, textLine{
+			text:  line,
+			x:     x + g.getAlignOffset(prop.Align, width, lineWidth),
+			y:     y + float64(i)*(fontHeight+prop.VerticalPadding),
+			width: lineWidth,
+		})
+	}
+
+	return textLines
+}
+
+func (g *provider) getAlignOffset(textAlign align.Type, width, lineWidth float64) float64 {
+	switch textAlign {
+	case align.Left:
+		return 0
+	case align.Right:
+		return width - lineWidth
+	default:
+		return (width - lineWidth) / 2
+	}
+}
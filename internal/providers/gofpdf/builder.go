@@ -17,6 +17,7 @@ type Dependencies struct {
 	Fpdf       gofpdfwrapper.Fpdf
 	Font       core.Font
 	Text       core.Text
+	Link       core.Link
 	Code       core.Code
 	Image      core.Image
 	Line       core.Line
@@ -53,17 +54,16 @@ func (b *builder) Build(cfg *entity.Config, cache cache.Cache) *Dependencies {
 		fpdf.AddUTF8FontFromBytes(font.Family, string(font.Style), font.Bytes)
 	}
 
-	if cfg.DisableAutoPageBreak {
-		fpdf.SetAutoPageBreak(false, 0)
-	}
+	// The rows are paginated by maroto, so gofpdf must not break pages.
+	fpdf.SetAutoPageBreak(false, cfg.Margins.Bottom)
 
 	fpdf.SetMargins(cfg.Margins.Left, cfg.Margins.Top, cfg.Margins.Right)
-	fpdf.AddPage()
 
 	font := NewFont(fpdf, cfg.DefaultFont.Size, cfg.DefaultFont.Family, cfg.DefaultFont.Style)
 	math := math.New()
 	code := code.New()
-	text := NewText(fpdf, math, font)
+	link := NewLink(fpdf)
+	text := NewText(fpdf, math, font, link)
 	image := NewImage(fpdf, math)
 	line := NewLine(fpdf)
 	cellWriter := cellwriter.NewBuilder().
@@ -73,6 +73,7 @@ func (b *builder) Build(cfg *entity.Config, cache cache.Cache) *Dependencies {
 		Fpdf:       fpdf,
 		Font:       font,
 		Text:       text,
+		Link:       link,
 		Code:       code,
 		Image:      image,
 		Line:       line,
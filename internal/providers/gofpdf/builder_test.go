@@ -7,6 +7,7 @@ import (
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/internal/providers/gofpdf"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontfamily"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagesize"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/stretchr/testify/assert"
 )
@@ -50,3 +51,39 @@ func TestBuilder_Build(t *testing.T) {
 	// Assert
 	assert.NotNil(t, dep)
 }
+
+func TestBuilder_Build_AutoPageBreak(t *testing.T) {
+	t.Run("when bottom margin is default, should disable the auto page break of gofpdf", func(t *testing.T) {
+		// Arrange
+		cfg := getBuilderConfig(pagesize.DefaultBottomMargin)
+
+		// Act
+		dep := gofpdf.NewBuilder().Build(cfg, nil)
+
+		// Assert
+		auto, margin := dep.Fpdf.GetAutoPageBreak()
+		assert.False(t, auto)
+		assert.Equal(t, pagesize.DefaultBottomMargin, margin)
+	})
+	t.Run("when bottom margin is custom, should disable the auto page break of gofpdf", func(t *testing.T) {
+		// Arrange
+		cfg := getBuilderConfig(5)
+
+		// Act
+		dep := gofpdf.NewBuilder().Build(cfg, nil)
+
+		// Assert
+		auto, margin := dep.Fpdf.GetAutoPageBreak()
+		assert.False(t, auto)
+		assert.Equal(t, 5.0, margin)
+	})
+}
+
+func getBuilderConfig(bottomMargin float64) *entity.Config {
+	font := fixture.FontProp()
+	return &entity.Config{
+		Dimensions:  &entity.Dimensions{Width: 210, Height: 297},
+		Margins:     &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: bottomMargin},
+		DefaultFont: &font,
+	}
+}
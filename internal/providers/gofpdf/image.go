@@ -46,6 +46,11 @@ func (s *image) Add(img *entity.Image, cell *entity.Cell, margins *entity.Margin
 		return errors.New("could not register image options, maybe path/name is wrong")
 	}
 
+	// gofpdf keeps the error of an invalid image instead of returning it.
+	if err := s.pdf.Error(); err != nil {
+		return err
+	}
+
 	s.addImageToPdf(imageID.String(), info, cell, margins, prop, flow)
 	return nil
 }
@@ -1,6 +1,7 @@
 package gofpdf_test
 
 import (
+	"errors"
 	"bytes"
 	"fmt"
 	"testing"
@@ -46,6 +47,30 @@ func TestImage_Add(t *testing.T) {
 		// Assert
 		assert.NotNil(t, err)
 	})
+	t.Run("when image is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := fixture.RectProp()
+		img := fixture.ImageEntity()
+		options := gofpdf.ImageOptions{
+			ReadDpi:   false,
+			ImageType: string(img.Extension),
+		}
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, options, bytes.NewReader(img.Bytes)).Return(&gofpdf.ImageInfoType{})
+		pdf.EXPECT().Error().Return(errors.New("unexpected EOF"))
+
+		image := gofpdf2.NewImage(pdf, &mocks.Math{})
+
+		// Act
+		err := image.Add(&img, &cell, &margins, &rect, img.Extension, true)
+
+		// Assert
+		assert.NotNil(t, err)
+		pdf.AssertNotCalled(t, "Image")
+	})
 	t.Run("when prop is not center, should work properly", func(t *testing.T) {
 		// Arrange
 		cell := fixture.CellEntity()
@@ -59,6 +84,7 @@ func TestImage_Add(t *testing.T) {
 
 		pdf := &mocks.Fpdf{}
 		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, options, bytes.NewReader(img.Bytes)).Return(&gofpdf.ImageInfoType{})
+		pdf.EXPECT().Error().Return(nil)
 		pdf.EXPECT().Image(mock.Anything, 30.0, 35.0, 98.0, mock.Anything, true, "", 0, "")
 
 		m := math.New()
@@ -85,6 +111,7 @@ func TestImage_Add(t *testing.T) {
 
 		pdf := &mocks.Fpdf{}
 		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, options, bytes.NewReader(img.Bytes)).Return(&gofpdf.ImageInfoType{})
+		pdf.EXPECT().Error().Return(nil)
 		pdf.EXPECT().Image(mock.Anything, 21.0, mock.Anything, 98.0, mock.Anything, true, "", 0, "")
 
 		m := math.New()
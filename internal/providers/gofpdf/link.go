@@ -0,0 +1,60 @@
+package gofpdf
+
+import (
+	"github.com/miguelbernadi/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+)
+
+type link struct {
+	pdf gofpdfwrapper.Fpdf
+	ids map[string]int
+	// pages has the page where each link was first placed, used while
+	// the destination of the link is unknown.
+	pages        map[string]int
+	destinations map[string]bool
+}
+
+// NewLink create a Link.
+func NewLink(pdf gofpdfwrapper.Fpdf) *link {
+	return &link{
+		pdf:          pdf,
+		ids:          make(map[string]int),
+		pages:        make(map[string]int),
+		destinations: make(map[string]bool),
+	}
+}
+
+// Add a clickable area in the current page that jumps to a named destination.
+// The destination can be defined before or after the link is added.
+func (l *link) Add(name string, x, y, width, height float64) {
+	if _, ok := l.pages[name]; !ok {
+		l.pages[name] = l.pdf.PageNo()
+	}
+
+	l.pdf.Link(x, y, width, height, l.getID(name))
+}
+
+// SetDestination defines the current position as the named destination.
+func (l *link) SetDestination(name string) {
+	l.pdf.SetLink(l.getID(name), -1, -1)
+	l.destinations[name] = true
+}
+
+// SetMissingDestinations points links without destination to the top of
+// the page where they were placed, as a link without a page is not valid.
+func (l *link) SetMissingDestinations() {
+	for name, page := range l.pages {
+		if !l.destinations[name] {
+			l.pdf.SetLink(l.ids[name], 0, page)
+		}
+	}
+}
+
+func (l *link) getID(name string) int {
+	id, ok := l.ids[name]
+	if !ok {
+		id = l.pdf.AddLink()
+		l.ids[name] = id
+	}
+
+	return id
+}
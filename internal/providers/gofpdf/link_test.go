@@ -0,0 +1,77 @@
+package gofpdf_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/internal/providers/gofpdf"
+	"github.com/miguelbernadi/maroto/v2/mocks"
+)
+
+func TestNewLink(t *testing.T) {
+	// Act
+	sut := gofpdf.NewLink(nil)
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*gofpdf.link", fmt.Sprintf("%T", sut))
+}
+
+func TestLink_Add(t *testing.T) {
+	t.Run("when destination is added later, should use the same link", func(t *testing.T) {
+		// Arrange
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().AddLink().Return(1).Once()
+		fpdf.EXPECT().PageNo().Return(1)
+		fpdf.EXPECT().Link(10.0, 20.0, 30.0, 5.0, 1)
+		fpdf.EXPECT().SetLink(1, -1.0, -1)
+
+		sut := gofpdf.NewLink(fpdf)
+
+		// Act
+		sut.Add("details", 10, 20, 30, 5)
+		sut.SetDestination("details")
+		sut.SetMissingDestinations()
+
+		// Assert
+		fpdf.AssertNumberOfCalls(t, "AddLink", 1)
+		fpdf.AssertNumberOfCalls(t, "Link", 1)
+		fpdf.AssertNumberOfCalls(t, "SetLink", 1)
+	})
+	t.Run("when destination is not added, should point to the page of the link", func(t *testing.T) {
+		// Arrange
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().AddLink().Return(1).Once()
+		fpdf.EXPECT().PageNo().Return(3)
+		fpdf.EXPECT().Link(10.0, 20.0, 30.0, 5.0, 1)
+		fpdf.EXPECT().SetLink(1, 0.0, 3)
+
+		sut := gofpdf.NewLink(fpdf)
+
+		// Act
+		sut.Add("details", 10, 20, 30, 5)
+		sut.SetMissingDestinations()
+
+		// Assert
+		fpdf.AssertCalled(t, "SetLink", 1, 0.0, 3)
+	})
+}
+
+func TestLink_SetDestination(t *testing.T) {
+	// Arrange
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().AddLink().Return(2).Once()
+	fpdf.EXPECT().SetLink(2, -1.0, -1)
+
+	sut := gofpdf.NewLink(fpdf)
+
+	// Act
+	sut.SetDestination("details")
+	sut.SetMissingDestinations()
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "AddLink", 1)
+	fpdf.AssertNumberOfCalls(t, "SetLink", 1)
+}
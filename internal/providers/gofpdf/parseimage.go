@@ -1,7 +1,13 @@
 package gofpdf
 
 import (
+	"bytes"
 	"errors"
+	goimage "image"
+
+	// Registers the decoders used to read the image dimensions.
+	_ "image/jpeg"
+	_ "image/png"
 
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
@@ -17,3 +23,21 @@ func FromBytes(bytes []byte, ext extension.Type) (*entity.Image, error) {
 		Extension: ext,
 	}, nil
 }
+
+// GetDimensions returns the dimensions of an image, decoding its header when
+// the dimensions are not already known.
+func GetDimensions(img *entity.Image) (*entity.Dimensions, error) {
+	if img.Dimensions != nil {
+		return img.Dimensions, nil
+	}
+
+	config, _, err := goimage.DecodeConfig(bytes.NewReader(img.Bytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Dimensions{
+		Width:  float64(config.Width),
+		Height: float64(config.Height),
+	}, nil
+}
@@ -1,11 +1,15 @@
 package gofpdf_test
 
 import (
+	"bytes"
+	goimage "image"
+	"image/png"
 	"testing"
 
 	"github.com/miguelbernadi/maroto/v2/internal/providers/gofpdf"
 
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,3 +31,41 @@ func TestFromBytes(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestGetDimensions(t *testing.T) {
+	t.Run("when image has dimensions, should return them", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{Dimensions: &entity.Dimensions{Width: 10, Height: 20}}
+
+		// Act
+		dimensions, err := gofpdf.GetDimensions(img)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, img.Dimensions, dimensions)
+	})
+	t.Run("when image cannot be decoded, should return error", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Extension: extension.Jpg}
+
+		// Act
+		dimensions, err := gofpdf.GetDimensions(img)
+
+		// Assert
+		assert.Nil(t, dimensions)
+		assert.NotNil(t, err)
+	})
+	t.Run("when image can be decoded, should return its dimensions", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		_ = png.Encode(&buf, goimage.NewRGBA(goimage.Rect(0, 0, 30, 15)))
+		img := &entity.Image{Bytes: buf.Bytes(), Extension: extension.Png}
+
+		// Act
+		dimensions, err := gofpdf.GetDimensions(img)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Dimensions{Width: 30, Height: 15}, dimensions)
+	})
+}
@@ -2,9 +2,12 @@ package gofpdf
 
 import (
 	"bytes"
+	"io"
 	"path/filepath"
 	"strings"
 
+	"github.com/jung-kurt/gofpdf"
+
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/barcode"
 
 	"github.com/miguelbernadi/maroto/v2/internal/cache"
@@ -21,26 +24,33 @@ type provider struct {
 	fpdf       gofpdfwrapper.Fpdf
 	font       core.Font
 	text       core.Text
+	link       core.Link
 	code       core.Code
 	image      core.Image
 	line       core.Line
 	cache      cache.Cache
 	cellWriter cellwriter.CellWriter
 	cfg        *entity.Config
+	// bookmarkLevel is the level of the last bookmark, -1 when there is none.
+	bookmarkLevel int
+	// errors are the failures of components collected in strict mode.
+	errors core.ComponentErrors
 }
 
 // New is the constructor of provider for gofpdf
 func New(dep *Dependencies) core.Provider {
 	return &provider{
-		fpdf:       dep.Fpdf,
-		font:       dep.Font,
-		text:       dep.Text,
-		code:       dep.Code,
-		image:      dep.Image,
-		line:       dep.Line,
-		cellWriter: dep.CellWriter,
-		cfg:        dep.Cfg,
-		cache:      dep.Cache,
+		fpdf:          dep.Fpdf,
+		font:          dep.Font,
+		text:          dep.Text,
+		link:          dep.Link,
+		code:          dep.Code,
+		image:         dep.Image,
+		line:          dep.Line,
+		cellWriter:    dep.CellWriter,
+		cfg:           dep.Cfg,
+		cache:         dep.Cache,
+		bookmarkLevel: -1,
 	}
 }
 
@@ -52,6 +62,18 @@ func (g *provider) GetTextHeight(prop *props.Font) float64 {
 	return g.font.GetHeight(prop.Family, prop.Style, prop.Size)
 }
 
+func (g *provider) GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int {
+	return g.text.GetLinesQuantity(text, *textProp, colWidth)
+}
+
+func (g *provider) GetLines(text string, textProp *props.Text, colWidth float64) []string {
+	return g.text.GetLines(text, textProp, colWidth)
+}
+
+func (g *provider) GetStringWidth(text string, textProp *props.Text) float64 {
+	return g.text.GetStringWidth(text, textProp)
+}
+
 func (g *provider) AddLine(cell *entity.Cell, prop *props.Line) {
 	g.line.Add(cell, prop)
 }
@@ -62,7 +84,7 @@ func (g *provider) AddMatrixCode(code string, cell *entity.Cell, prop *props.Rec
 		image, err = g.code.GenDataMatrix(code)
 	}
 	if err != nil {
-		g.text.Add("could not generate matrixcode", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not generate matrixcode", err)
 		return
 	}
 
@@ -70,7 +92,7 @@ func (g *provider) AddMatrixCode(code string, cell *entity.Cell, prop *props.Rec
 	err = g.image.Add(image, cell, g.cfg.Margins, prop, extension.Jpg, false)
 	if err != nil {
 		g.fpdf.ClearError()
-		g.text.Add("could not add matrixcode to document", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not add matrixcode to document", err)
 	}
 }
 
@@ -80,7 +102,7 @@ func (g *provider) AddQrCode(code string, cell *entity.Cell, prop *props.Rect) {
 		image, err = g.code.GenQr(code)
 	}
 	if err != nil {
-		g.text.Add("could not generate qrcode", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not generate qrcode", err)
 		return
 	}
 
@@ -88,7 +110,7 @@ func (g *provider) AddQrCode(code string, cell *entity.Cell, prop *props.Rect) {
 	err = g.image.Add(image, cell, g.cfg.Margins, prop, extension.Jpg, false)
 	if err != nil {
 		g.fpdf.ClearError()
-		g.text.Add("could not add qrcode to document", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not add qrcode to document", err)
 	}
 }
 
@@ -98,7 +120,7 @@ func (g *provider) AddBarCode(code string, cell *entity.Cell, prop *props.Barcod
 		image, err = g.code.GenBar(code, cell, prop)
 	}
 	if err != nil {
-		g.text.Add("could not generate barcode", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not generate barcode", err)
 		return
 	}
 
@@ -106,7 +128,7 @@ func (g *provider) AddBarCode(code string, cell *entity.Cell, prop *props.Barcod
 	err = g.image.Add(image, cell, g.cfg.Margins, prop.ToRectProp(), extension.Jpg, false)
 	if err != nil {
 		g.fpdf.ClearError()
-		g.text.Add("could not add barcode to document", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not add barcode to document", err)
 	}
 }
 
@@ -121,13 +143,13 @@ func (g *provider) AddImageFromFile(file string, cell *entity.Cell, prop *props.
 	}
 
 	if err != nil {
-		g.text.Add("could not load image", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not load image", err)
 		return
 	}
 
 	image, err = g.cache.GetImage(file, extension.Type(extensionStr))
 	if err != nil {
-		g.text.Add("could not load image", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not load image", err)
 		return
 	}
 
@@ -137,36 +159,146 @@ func (g *provider) AddImageFromFile(file string, cell *entity.Cell, prop *props.
 func (g *provider) AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type) {
 	img, err := FromBytes(bytes, extension)
 	if err != nil {
-		g.text.Add("could not parse image bytes", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not parse image bytes", err)
 		return
 	}
 
 	err = g.image.Add(img, cell, g.cfg.Margins, prop, extension, false)
 	if err != nil {
 		g.fpdf.ClearError()
-		g.text.Add("could not add image to document", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not add image to document", err)
 	}
 }
 
 func (g *provider) AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type) {
 	img, err := FromBytes(bytes, extension)
 	if err != nil {
-		g.text.Add("could not parse image bytes", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not parse image bytes", err)
 		return
 	}
 
 	err = g.image.Add(img, cell, g.cfg.Margins, prop, extension, true)
 	if err != nil {
 		g.fpdf.ClearError()
-		g.text.Add("could not add image to document", cell, merror.DefaultErrorText)
+		g.addError(cell, "could not add image to document", err)
 	}
 	g.fpdf.SetHomeXY()
 }
 
+func (g *provider) AddTextWatermark(text string, cell *entity.Cell, prop *props.Watermark) {
+	g.beginWatermark(cell, prop)
+	g.text.Add(text, cell, prop.ToTextProp())
+	g.endWatermark()
+}
+
+func (g *provider) AddImageWatermark(bytes []byte, cell *entity.Cell, prop *props.Watermark, extension extension.Type) {
+	img, err := FromBytes(bytes, extension)
+	if err != nil {
+		g.addError(cell, "could not parse image bytes", err)
+		return
+	}
+
+	g.beginWatermark(cell, prop)
+	err = g.image.Add(img, cell, g.cfg.Margins, &props.Rect{Center: true, Percent: prop.Percent}, extension, false)
+	g.endWatermark()
+	if err != nil {
+		g.fpdf.ClearError()
+		g.addError(cell, "could not add image to document", err)
+	}
+}
+
+func (g *provider) GetDimensionsByImage(file string) (*entity.Dimensions, error) {
+	extensionStr := strings.ToLower(strings.TrimPrefix(filepath.Ext(file), "."))
+	image, err := g.cache.GetImage(file, extension.Type(extensionStr))
+	if err != nil {
+		err = g.cache.LoadImage(file, extension.Type(extensionStr))
+		if err != nil {
+			return nil, err
+		}
+
+		image, err = g.cache.GetImage(file, extension.Type(extensionStr))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return GetDimensions(image)
+}
+
+func (g *provider) GetDimensionsByImageByte(bytes []byte, extension extension.Type) (*entity.Dimensions, error) {
+	img, err := FromBytes(bytes, extension)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetDimensions(img)
+}
+
+func (g *provider) GetDimensionsByQrCode(code string) (*entity.Dimensions, error) {
+	image, err := g.cache.GetImage(code, extension.Jpg)
+	if err != nil {
+		image, err = g.code.GenQr(code)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	g.cache.AddImage(code, image)
+	return GetDimensions(image)
+}
+
+func (g *provider) GetDimensionsByMatrixCode(code string) (*entity.Dimensions, error) {
+	image, err := g.cache.GetImage(code, extension.Jpg)
+	if err != nil {
+		image, err = g.code.GenDataMatrix(code)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	g.cache.AddImage(code, image)
+	return GetDimensions(image)
+}
+
+// AddPage starts a new page with the width and height, which may
+// differ from the dimensions of the document.
+func (g *provider) AddPage(width, height float64) {
+	g.fpdf.AddPageFormat("P", gofpdf.SizeType{Wd: width, Ht: height})
+}
+
 func (g *provider) CreateRow(height float64) {
 	g.fpdf.Ln(height)
 }
 
+// SetPosition moves the position of the next col to x and y,
+// which are relative to the left and top margins.
+func (g *provider) SetPosition(x, y float64) {
+	left, top, _, _ := g.fpdf.GetMargins()
+	g.fpdf.SetXY(left+x, top+y)
+}
+
+// AddBookmark adds an outline entry pointing to the current position.
+// The PDF outline cannot skip levels, so the level is limited to one
+// deeper than the previous bookmark.
+func (g *provider) AddBookmark(title string, level int) {
+	if level < 0 {
+		level = 0
+	}
+
+	if level > g.bookmarkLevel+1 {
+		level = g.bookmarkLevel + 1
+	}
+
+	g.bookmarkLevel = level
+	g.fpdf.Bookmark(title, level, -1)
+}
+
+// AddDestination defines the current position as a named destination,
+// which is the target of texts with props.Text.InternalLink.
+func (g *provider) AddDestination(name string) {
+	g.link.SetDestination(name)
+}
+
 func (g *provider) SetProtection(protection *entity.Protection) {
 	if protection == nil {
 		return
@@ -203,11 +335,23 @@ func (g *provider) SetMetadata(metadata *entity.Metadata) {
 
 func (g *provider) GenerateBytes() ([]byte, error) {
 	var buffer bytes.Buffer
-	err := g.fpdf.Output(&buffer)
+	err := g.GenerateTo(&buffer)
 
 	return buffer.Bytes(), err
 }
 
+// GenerateTo writes the document to w. The pages are kept in memory by
+// gofpdf until the document is written. In strict mode, the failures of
+// components are returned instead of writing the document.
+func (g *provider) GenerateTo(w io.Writer) error {
+	if len(g.errors) > 0 {
+		return g.errors
+	}
+
+	g.link.SetMissingDestinations()
+	return g.fpdf.Output(w)
+}
+
 func (g *provider) CreateCol(width, height float64, config *entity.Config, prop *props.Cell) {
 	g.cellWriter.Apply(width, height, config, prop)
 }
@@ -216,6 +360,38 @@ func (g *provider) SetCompression(compression bool) {
 	g.fpdf.SetCompression(compression)
 }
 
+// beginWatermark applies the opacity and the rotation, around the center of the cell,
+// to everything drawn until endWatermark is called.
+func (g *provider) beginWatermark(cell *entity.Cell, prop *props.Watermark) {
+	left, top, _, _ := g.fpdf.GetMargins()
+	x := left + cell.X + cell.Width/2
+	y := top + cell.Y + cell.Height/2
+
+	g.fpdf.SetAlpha(prop.Opacity, "Normal")
+	g.fpdf.TransformBegin()
+	g.fpdf.TransformRotate(prop.Angle, x, y)
+}
+
+func (g *provider) endWatermark() {
+	g.fpdf.TransformEnd()
+	g.fpdf.SetAlpha(1, "Normal")
+}
+
+// addError adds an error text in the cell of a component that failed, or
+// collects the failure to be returned by GenerateTo in strict mode.
+func (g *provider) addError(cell *entity.Cell, message string, err error) {
+	if g.cfg == nil || !g.cfg.StrictMode {
+		g.text.Add(message, cell, merror.DefaultErrorText)
+		return
+	}
+
+	g.errors = append(g.errors, &core.ComponentError{
+		Path:    cell.Path,
+		Message: message,
+		Err:     err,
+	})
+}
+
 func (g *provider) getBarcodeImageName(code string, prop *props.Barcode) string {
 	if prop == nil {
 		return code + string(barcode.Code128)
@@ -1,24 +1,31 @@
 package gofpdf_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	gofpdflib "github.com/jung-kurt/gofpdf"
+
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/barcode"
 
+	"github.com/stretchr/testify/mock"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/internal/merror"
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/protection"
-	"github.com/stretchr/testify/mock"
 
 	"github.com/miguelbernadi/maroto/v2/internal/providers/gofpdf"
 
-	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
 )
 
 const (
@@ -76,6 +83,69 @@ func TestProvider_GetTextHeight(t *testing.T) {
 	assert.Equal(t, fontHeightToReturn, fontHeight)
 }
 
+func TestProvider_GetLinesQuantity(t *testing.T) {
+	// Arrange
+	txtContent := "text"
+	prop := fixture.TextProp()
+
+	text := &mocks.Text{}
+	text.EXPECT().GetLinesQuantity(txtContent, prop, 10.0).Return(3)
+
+	dep := &gofpdf.Dependencies{
+		Text: text,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	lines := sut.GetLinesQuantity(txtContent, &prop, 10.0)
+
+	// Assert
+	text.AssertNumberOfCalls(t, "GetLinesQuantity", 1)
+	assert.Equal(t, 3, lines)
+}
+
+func TestProvider_GetLines(t *testing.T) {
+	// Arrange
+	txtContent := "many words"
+	prop := fixture.TextProp()
+
+	text := &mocks.Text{}
+	text.EXPECT().GetLines(txtContent, &prop, 10.0).Return([]string{"many ", "words "})
+
+	dep := &gofpdf.Dependencies{
+		Text: text,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	lines := sut.GetLines(txtContent, &prop, 10.0)
+
+	// Assert
+	text.AssertNumberOfCalls(t, "GetLines", 1)
+	assert.Equal(t, []string{"many ", "words "}, lines)
+}
+
+func TestProvider_GetStringWidth(t *testing.T) {
+	// Arrange
+	txtContent := "text"
+	prop := fixture.TextProp()
+
+	text := &mocks.Text{}
+	text.EXPECT().GetStringWidth(txtContent, &prop).Return(12.5)
+
+	dep := &gofpdf.Dependencies{
+		Text: text,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	width := sut.GetStringWidth(txtContent, &prop)
+
+	// Assert
+	text.AssertNumberOfCalls(t, "GetStringWidth", 1)
+	assert.Equal(t, 12.5, width)
+}
+
 func TestProvider_AddLine(t *testing.T) {
 	// Arrange
 	cell := &entity.Cell{}
@@ -515,6 +585,140 @@ func TestProvider_AddBarCode(t *testing.T) {
 	})
 }
 
+func TestProvider_GetDimensionsByQrCode(t *testing.T) {
+	t.Run("when cannot find image on cache and cannot generate qr code, should return error", func(t *testing.T) {
+		// Arrange
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage(codeContent, extension.Jpg).Return(nil, errors.New("anyError1"))
+
+		code := &mocks.Code{}
+		code.EXPECT().GenQr(codeContent).Return(nil, errors.New("anyError2"))
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		dimensions, err := sut.GetDimensionsByQrCode(codeContent)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, dimensions)
+	})
+	t.Run("when can find image on cache, should return its dimensions", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{
+			Extension:  extension.Jpg,
+			Dimensions: &entity.Dimensions{Width: 25, Height: 25},
+		}
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage(codeContent, extension.Jpg).Return(img, nil)
+		cache.EXPECT().AddImage(codeContent, img)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		dimensions, err := sut.GetDimensionsByQrCode(codeContent)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, img.Dimensions, dimensions)
+	})
+}
+
+func TestProvider_GetDimensionsByMatrixCode(t *testing.T) {
+	t.Run("when cannot find image on cache and cannot generate data matrix, should return error", func(t *testing.T) {
+		// Arrange
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage(codeContent, extension.Jpg).Return(nil, errors.New("anyError1"))
+
+		code := &mocks.Code{}
+		code.EXPECT().GenDataMatrix(codeContent).Return(nil, errors.New("anyError2"))
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		dimensions, err := sut.GetDimensionsByMatrixCode(codeContent)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, dimensions)
+	})
+	t.Run("when cannot find image on cache but can generate data matrix, should return its dimensions", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{
+			Extension:  extension.Jpg,
+			Dimensions: &entity.Dimensions{Width: 20, Height: 20},
+		}
+
+		cache := &mocks.Cache{}
+		cache.EXPECT().GetImage(codeContent, extension.Jpg).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().AddImage(codeContent, img)
+
+		code := &mocks.Code{}
+		code.EXPECT().GenDataMatrix(codeContent).Return(img, nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		dimensions, err := sut.GetDimensionsByMatrixCode(codeContent)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, img.Dimensions, dimensions)
+	})
+}
+
+func TestProvider_GetDimensionsByImageByte(t *testing.T) {
+	t.Run("when image is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.New(&gofpdf.Dependencies{})
+
+		// Act
+		dimensions, err := sut.GetDimensionsByImageByte([]byte{1, 2, 3}, extension.Png)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, dimensions)
+	})
+}
+
+func TestProvider_AddPage(t *testing.T) {
+	// Arrange
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().AddPageFormat("P", gofpdflib.SizeType{Wd: 297, Ht: 210})
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddPage(297, 210)
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "AddPageFormat", 1)
+}
+
 func TestProvider_CreateRow(t *testing.T) {
 	// Arrange
 	height := 10.0
@@ -535,6 +739,87 @@ func TestProvider_CreateRow(t *testing.T) {
 	fpdf.AssertNumberOfCalls(t, "Ln", 1)
 }
 
+func TestProvider_SetPosition(t *testing.T) {
+	// Arrange
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().GetMargins().Return(10.0, 15.0, 10.0, 20.0)
+	fpdf.EXPECT().SetXY(30.0, 55.0)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.SetPosition(20, 40)
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "SetXY", 1)
+}
+
+func TestProvider_AddBookmark(t *testing.T) {
+	t.Run("when levels are sequential, should add bookmarks with the same levels", func(t *testing.T) {
+		// Arrange
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().Bookmark("chapter", 0, -1.0)
+		fpdf.EXPECT().Bookmark("section", 1, -1.0)
+
+		sut := gofpdf.New(&gofpdf.Dependencies{Fpdf: fpdf})
+
+		// Act
+		sut.AddBookmark("chapter", 0)
+		sut.AddBookmark("section", 1)
+
+		// Assert
+		fpdf.AssertCalled(t, "Bookmark", "chapter", 0, -1.0)
+		fpdf.AssertCalled(t, "Bookmark", "section", 1, -1.0)
+	})
+	t.Run("when level skips levels, should limit to one level deeper than the previous", func(t *testing.T) {
+		// Arrange
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().Bookmark("section", 0, -1.0)
+		fpdf.EXPECT().Bookmark("subsection", 1, -1.0)
+
+		sut := gofpdf.New(&gofpdf.Dependencies{Fpdf: fpdf})
+
+		// Act
+		sut.AddBookmark("section", 1)
+		sut.AddBookmark("subsection", 3)
+
+		// Assert
+		fpdf.AssertCalled(t, "Bookmark", "section", 0, -1.0)
+		fpdf.AssertCalled(t, "Bookmark", "subsection", 1, -1.0)
+	})
+	t.Run("when level is negative, should add a top level bookmark", func(t *testing.T) {
+		// Arrange
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().Bookmark("chapter", 0, -1.0)
+
+		sut := gofpdf.New(&gofpdf.Dependencies{Fpdf: fpdf})
+
+		// Act
+		sut.AddBookmark("chapter", -1)
+
+		// Assert
+		fpdf.AssertCalled(t, "Bookmark", "chapter", 0, -1.0)
+	})
+}
+
+func TestProvider_AddDestination(t *testing.T) {
+	// Arrange
+	link := &mocks.Link{}
+	link.EXPECT().SetDestination("details")
+
+	sut := gofpdf.New(&gofpdf.Dependencies{Link: link})
+
+	// Act
+	sut.AddDestination("details")
+
+	// Assert
+	link.AssertNumberOfCalls(t, "SetDestination", 1)
+}
+
 func TestProvider_CreateCol(t *testing.T) {
 	// Arrange
 	width := 10.0
@@ -671,8 +956,12 @@ func TestProvider_GenerateBytes(t *testing.T) {
 	fpdf := &mocks.Fpdf{}
 	fpdf.EXPECT().Output(mock.Anything).Return(errors.New("anyError"))
 
+	link := &mocks.Link{}
+	link.EXPECT().SetMissingDestinations()
+
 	dep := &gofpdf.Dependencies{
 		Fpdf: fpdf,
+		Link: link,
 	}
 	sut := gofpdf.New(dep)
 
@@ -683,6 +972,32 @@ func TestProvider_GenerateBytes(t *testing.T) {
 	assert.Nil(t, bytes)
 	assert.NotNil(t, err)
 	fpdf.AssertNumberOfCalls(t, "Output", 1)
+	link.AssertNumberOfCalls(t, "SetMissingDestinations", 1)
+}
+
+func TestProvider_GenerateTo(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+
+	fpdf := &mocks.Fpdf{}
+	fpdf.EXPECT().Output(&buf).Return(nil)
+
+	link := &mocks.Link{}
+	link.EXPECT().SetMissingDestinations()
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+		Link: link,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	err := sut.GenerateTo(&buf)
+
+	// Assert
+	assert.Nil(t, err)
+	fpdf.AssertNumberOfCalls(t, "Output", 1)
+	link.AssertNumberOfCalls(t, "SetMissingDestinations", 1)
 }
 
 func TestProvider_AddImageFromBytes(t *testing.T) {
@@ -706,6 +1021,30 @@ func TestProvider_AddImageFromBytes(t *testing.T) {
 		// Assert
 		text.AssertNumberOfCalls(t, "Add", 1)
 	})
+	t.Run("when image is invalid and strict mode is enabled, should return error on generate", func(t *testing.T) {
+		// Arrange
+		prop := fixture.RectProp()
+		cell := &entity.Cell{Path: entity.Path{Page: 2, Row: 3, Col: 1}}
+
+		dep := &gofpdf.Dependencies{
+			Text: &mocks.Text{},
+			Cfg:  &entity.Config{StrictMode: true},
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddImageFromBytes([]byte{1, 2, 3}, cell, &prop, "invalid")
+		bytes, err := sut.GenerateBytes()
+
+		// Assert
+		var componentErr *core.ComponentError
+		assert.Empty(t, bytes)
+		assert.True(t, errors.As(err, &componentErr))
+		assert.Equal(t, entity.Path{Page: 2, Row: 3, Col: 1}, componentErr.Path)
+		assert.Equal(t, "could not parse image bytes", componentErr.Message)
+		assert.NotNil(t, componentErr.Err)
+	})
 	t.Run("when image is valid but cannot add to document, should apply message error", func(t *testing.T) {
 		// Arrange
 		img := &entity.Image{
@@ -894,6 +1233,144 @@ func TestProvider_AddBackgroundImageFromBytes(t *testing.T) {
 	})
 }
 
+func TestProvider_AddTextWatermark(t *testing.T) {
+	t.Run("when called, should draw the text rotated around the center of the cell with the opacity", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 0, Y: 0, Width: 100, Height: 200}
+		prop := &props.Watermark{Angle: 45, Opacity: 0.3, Size: 80}
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().GetMargins().Return(10, 20, 10, 20)
+		fpdf.EXPECT().SetAlpha(0.3, "Normal")
+		fpdf.EXPECT().TransformBegin()
+		fpdf.EXPECT().TransformRotate(45.0, 60.0, 120.0)
+		fpdf.EXPECT().TransformEnd()
+		fpdf.EXPECT().SetAlpha(1.0, "Normal")
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("DRAFT", cell, prop.ToTextProp())
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+			Text: text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddTextWatermark("DRAFT", cell, prop)
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+		fpdf.AssertNumberOfCalls(t, "SetAlpha", 2)
+		fpdf.AssertNumberOfCalls(t, "TransformRotate", 1)
+		fpdf.AssertNumberOfCalls(t, "TransformEnd", 1)
+	})
+}
+
+func TestProvider_AddImageWatermark(t *testing.T) {
+	t.Run("when image is invalid, should apply message error", func(t *testing.T) {
+		// Arrange
+		prop := &props.Watermark{}
+		cell := &entity.Cell{}
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not parse image bytes", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Text: text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddImageWatermark([]byte{1, 2, 3}, cell, prop, "invalid")
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when image is valid but cannot add to document, should end the transform and apply message error", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{
+			Bytes:     []byte{1, 2, 3},
+			Extension: extension.Jpg,
+		}
+		prop := &props.Watermark{Angle: 30, Opacity: 0.5, Percent: 50}
+		cell := &entity.Cell{Width: 100, Height: 200}
+		cfg := &entity.Config{Margins: &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10}}
+
+		text := &mocks.Text{}
+		text.EXPECT().Add("could not add image to document", cell, merror.DefaultErrorText)
+
+		image := &mocks.Image{}
+		image.EXPECT().Add(img, cell, cfg.Margins, &props.Rect{Center: true, Percent: 50}, img.Extension, false).
+			Return(errors.New("anyError"))
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		fpdf.EXPECT().SetAlpha(0.5, "Normal")
+		fpdf.EXPECT().TransformBegin()
+		fpdf.EXPECT().TransformRotate(30.0, 60.0, 110.0)
+		fpdf.EXPECT().TransformEnd()
+		fpdf.EXPECT().SetAlpha(1.0, "Normal")
+		fpdf.EXPECT().ClearError()
+
+		dep := &gofpdf.Dependencies{
+			Text:  text,
+			Image: image,
+			Fpdf:  fpdf,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddImageWatermark(img.Bytes, cell, prop, img.Extension)
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+		fpdf.AssertNumberOfCalls(t, "TransformEnd", 1)
+		fpdf.AssertNumberOfCalls(t, "ClearError", 1)
+	})
+	t.Run("when image is valid and can add to document, should not apply message error", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{
+			Bytes:     []byte{1, 2, 3},
+			Extension: extension.Jpg,
+		}
+		prop := &props.Watermark{Opacity: 0.3, Percent: 100}
+		cell := &entity.Cell{Width: 100, Height: 200}
+		cfg := &entity.Config{Margins: &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10}}
+
+		image := &mocks.Image{}
+		image.EXPECT().Add(img, cell, cfg.Margins, &props.Rect{Center: true, Percent: 100}, img.Extension, false).Return(nil)
+
+		fpdf := &mocks.Fpdf{}
+		fpdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		fpdf.EXPECT().SetAlpha(0.3, "Normal")
+		fpdf.EXPECT().TransformBegin()
+		fpdf.EXPECT().TransformRotate(0.0, 60.0, 110.0)
+		fpdf.EXPECT().TransformEnd()
+		fpdf.EXPECT().SetAlpha(1.0, "Normal")
+
+		dep := &gofpdf.Dependencies{
+			Image: image,
+			Fpdf:  fpdf,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddImageWatermark(img.Bytes, cell, prop, img.Extension)
+
+		// Assert
+		image.AssertNumberOfCalls(t, "Add", 1)
+		fpdf.AssertNumberOfCalls(t, "TransformEnd", 1)
+	})
+}
+
 /*func TestProvider_AddImageFromFile(t *testing.T) {
 	t.Run("when cannot find image in cache and cannot load image, should apply error message", func(t *testing.T) {
 		// Arrange
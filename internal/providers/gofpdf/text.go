@@ -17,14 +17,16 @@ type text struct {
 	pdf  gofpdfwrapper.Fpdf
 	math core.Math
 	font core.Font
+	link core.Link
 }
 
 // NewText create a Text.
-func NewText(pdf gofpdfwrapper.Fpdf, math core.Math, font core.Font) *text {
+func NewText(pdf gofpdfwrapper.Fpdf, math core.Math, font core.Font, link core.Link) *text {
 	return &text{
 		pdf,
 		math,
 		font,
+		link,
 	}
 }
 
@@ -59,7 +61,7 @@ func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
 	}
 
 	// override style if hyperlink is set
-	if textProp.Hyperlink != nil {
+	if textProp.Hyperlink != nil || textProp.InternalLink != nil {
 		s.font.SetColor(&props.BlueColor)
 	}
 
@@ -71,6 +73,7 @@ func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
 
 	// If should add one line
 	if stringWidth < width {
+		y += textProp.GetVerticalOffset(cell.Height, fontHeight, 1)
 		s.addLine(textProp, x, width, y, stringWidth, unicodeText)
 		if textProp.Color != nil {
 			s.font.SetColor(originalColor)
@@ -78,18 +81,13 @@ func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
 		return
 	}
 
-	var lines []string
-
-	if textProp.BreakLineStrategy == breakline.EmptySpaceStrategy {
-		words := strings.Split(unicodeText, " ")
-		lines = s.getLinesBreakingLineFromSpace(words, width)
-	} else {
-		lines = s.getLinesBreakingLineWithDash(unicodeText, width)
-	}
+	lines := s.breakLines(text, textProp, width)
+	y += textProp.GetVerticalOffset(cell.Height, fontHeight, len(lines))
 
 	accumulateOffsetY := 0.0
 
 	for index, line := range lines {
+		line = s.textToUnicode(line, textProp)
 		lineWidth := s.pdf.GetStringWidth(line)
 
 		s.addLine(textProp, x, width, y+float64(index)*fontHeight+accumulateOffsetY, lineWidth, line)
@@ -103,25 +101,51 @@ func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
 
 // GetLinesQuantity retrieve the quantity of lines which a text will occupy to avoid that text to extrapolate a cell.
 func (s *text) GetLinesQuantity(text string, textProp props.Text, colWidth float64) int {
-	translator := s.pdf.UnicodeTranslatorFromDescriptor("")
 	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
 
 	// Apply Unicode.
-	textTranslated := translator(text)
-
-	stringWidth := s.pdf.GetStringWidth(textTranslated)
-	words := strings.Split(textTranslated, " ")
+	unicodeText := s.textToUnicode(text, &textProp)
+	stringWidth := s.pdf.GetStringWidth(unicodeText)
+	words := strings.Split(unicodeText, " ")
 
 	// If should add one line.
 	if stringWidth < colWidth || len(words) == 1 {
 		return 1
 	}
 
-	lines := s.getLinesBreakingLineFromSpace(words, colWidth)
-	return len(lines)
+	return len(s.breakLines(text, &textProp, colWidth))
+}
+
+// GetLines retrieve the lines of a text broken as they are added inside a cell with the width.
+// The lines keep the characters of the text, which are translated only when they are added.
+func (s *text) GetLines(text string, textProp *props.Text, colWidth float64) []string {
+	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
+
+	if s.GetStringWidth(text, textProp) < colWidth {
+		return []string{text}
+	}
+
+	return s.breakLines(text, textProp, colWidth)
 }
 
-func (s *text) getLinesBreakingLineFromSpace(words []string, colWidth float64) []string {
+// GetStringWidth retrieve the width which a text will occupy in a single line.
+func (s *text) GetStringWidth(text string, textProp *props.Text) float64 {
+	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
+	return s.pdf.GetStringWidth(s.textToUnicode(text, textProp))
+}
+
+// breakLines breaks the text in lines with the break line strategy, measuring
+// the characters of the text as they are translated when they are added.
+func (s *text) breakLines(text string, textProp *props.Text, colWidth float64) []string {
+	if textProp.BreakLineStrategy == breakline.EmptySpaceStrategy {
+		words := strings.Split(text, " ")
+		return s.getLinesBreakingLineFromSpace(words, textProp, colWidth)
+	}
+
+	return s.getLinesBreakingLineWithDash(text, textProp, colWidth)
+}
+
+func (s *text) getLinesBreakingLineFromSpace(words []string, textProp *props.Text, colWidth float64) []string {
 	currentlySize := 0.0
 	actualLine := 0
 
@@ -129,21 +153,22 @@ func (s *text) getLinesBreakingLineFromSpace(words []string, colWidth float64) [
 	lines = append(lines, "")
 
 	for _, word := range words {
-		if s.pdf.GetStringWidth(word+" ")+currentlySize < colWidth {
+		wordSize := s.pdf.GetStringWidth(s.textToUnicode(word+" ", textProp))
+		if wordSize+currentlySize < colWidth {
 			lines[actualLine] = lines[actualLine] + word + " "
-			currentlySize += s.pdf.GetStringWidth(word + " ")
+			currentlySize += wordSize
 		} else {
 			lines = append(lines, "")
 			actualLine++
 			lines[actualLine] = lines[actualLine] + word + " "
-			currentlySize = s.pdf.GetStringWidth(word + " ")
+			currentlySize = wordSize
 		}
 	}
 
 	return lines
 }
 
-func (s *text) getLinesBreakingLineWithDash(words string, colWidth float64) []string {
+func (s *text) getLinesBreakingLineWithDash(words string, textProp *props.Text, colWidth float64) []string {
 	currentlySize := 0.0
 
 	lines := []string{}
@@ -160,7 +185,7 @@ func (s *text) getLinesBreakingLineWithDash(words string, colWidth float64) []st
 		}
 
 		letterString := fmt.Sprintf("%c", letter)
-		width := s.pdf.GetStringWidth(letterString)
+		width := s.pdf.GetStringWidth(s.textToUnicode(letterString, textProp))
 		content += letterString
 		currentlySize += width
 	}
@@ -180,9 +205,7 @@ func (s *text) addLine(textProp *props.Text, xColOffset, colWidth, yColOffset, t
 	if textProp.Align == align.Left {
 		s.pdf.Text(xColOffset+left, yColOffset+top, text)
 
-		if textProp.Hyperlink != nil {
-			s.pdf.LinkString(xColOffset+left, yColOffset+top-fontHeight, textWidth, fontHeight, *textProp.Hyperlink)
-		}
+		s.addLink(textProp, xColOffset+left, yColOffset+top-fontHeight, textWidth, fontHeight)
 
 		return
 	}
@@ -195,11 +218,19 @@ func (s *text) addLine(textProp *props.Text, xColOffset, colWidth, yColOffset, t
 
 	dx := (colWidth - textWidth) / modifier
 
+	s.addLink(textProp, dx+xColOffset+left, yColOffset+top-fontHeight, textWidth, fontHeight)
+
+	s.pdf.Text(dx+xColOffset+left, yColOffset+top, text)
+}
+
+func (s *text) addLink(textProp *props.Text, x, y, width, height float64) {
 	if textProp.Hyperlink != nil {
-		s.pdf.LinkString(dx+xColOffset+left, yColOffset+top-fontHeight, textWidth, fontHeight, *textProp.Hyperlink)
+		s.pdf.LinkString(x, y, width, height, *textProp.Hyperlink)
 	}
 
-	s.pdf.Text(dx+xColOffset+left, yColOffset+top, text)
+	if textProp.InternalLink != nil {
+		s.link.Add(*textProp.InternalLink, x, y, width, height)
+	}
 }
 
 func (s *text) textToUnicode(txt string, props *props.Text) string {
@@ -5,18 +5,122 @@ import (
 	"testing"
 
 	"github.com/miguelbernadi/maroto/v2/internal/providers/gofpdf"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/breakline"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontfamily"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
 
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewText(t *testing.T) {
-	text := gofpdf.NewText(&mocks.Fpdf{}, &mocks.Math{}, &mocks.Font{})
+	text := gofpdf.NewText(&mocks.Fpdf{}, &mocks.Math{}, &mocks.Font{}, &mocks.Link{})
 
 	assert.NotNil(t, text)
 	assert.Equal(t, fmt.Sprintf("%T", text), "*gofpdf.text")
 }
 
+func TestText_Add_WhenHasInternalLink_ShouldAddLink(t *testing.T) {
+	// Arrange
+	name := "details"
+	prop := &props.Text{Family: fontfamily.Helvetica, Size: 10, Align: align.Left, InternalLink: &name}
+	cell := &entity.Cell{X: 5, Y: 10, Width: 100, Height: 20}
+
+	pdf := &mocks.Fpdf{}
+	pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+	pdf.EXPECT().GetStringWidth("text").Return(20.0)
+	pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+	pdf.EXPECT().Text(15.0, 24.0, "text")
+
+	font := &mocks.Font{}
+	font.EXPECT().SetFont(prop.Family, prop.Style, prop.Size)
+	font.EXPECT().GetHeight(prop.Family, prop.Style, prop.Size).Return(4.0)
+	font.EXPECT().GetColor().Return(&props.BlackColor)
+	font.EXPECT().SetColor(&props.BlueColor)
+
+	link := &mocks.Link{}
+	link.EXPECT().Add(name, 15.0, 20.0, 20.0, 4.0)
+
+	sut := gofpdf.NewText(pdf, nil, font, link)
+
+	// Act
+	sut.Add("text", cell, prop)
+
+	// Assert
+	link.AssertNumberOfCalls(t, "Add", 1)
+	font.AssertCalled(t, "SetColor", &props.BlueColor)
+}
+
+func TestText_Add_WhenVerticalAlignIsMiddle_ShouldCenterText(t *testing.T) {
+	// Arrange
+	prop := &props.Text{Family: fontfamily.Helvetica, Size: 10, Align: align.Left, VerticalAlign: align.Middle}
+	cell := &entity.Cell{X: 5, Y: 10, Width: 100, Height: 20}
+
+	pdf := &mocks.Fpdf{}
+	pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+	pdf.EXPECT().GetStringWidth("text").Return(20.0)
+	pdf.EXPECT().GetMargins().Return(10.0, 10.0, 10.0, 10.0)
+	pdf.EXPECT().Text(15.0, 32.0, "text")
+
+	font := &mocks.Font{}
+	font.EXPECT().SetFont(prop.Family, prop.Style, prop.Size)
+	font.EXPECT().GetHeight(prop.Family, prop.Style, prop.Size).Return(4.0)
+	font.EXPECT().GetColor().Return(&props.BlackColor)
+
+	sut := gofpdf.NewText(pdf, nil, font, nil)
+
+	// Act
+	sut.Add("text", cell, prop)
+
+	// Assert
+	pdf.AssertCalled(t, "Text", 15.0, 32.0, "text")
+}
+
+func TestText_GetLines(t *testing.T) {
+	t.Run("when text fits the width, should return one line", func(t *testing.T) {
+		// Arrange
+		prop := &props.Text{Family: fontfamily.Helvetica, Size: 10, BreakLineStrategy: breakline.EmptySpaceStrategy}
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetStringWidth("two words").Return(9.0)
+
+		font := &mocks.Font{}
+		font.EXPECT().SetFont(prop.Family, prop.Style, prop.Size)
+
+		sut := gofpdf.NewText(pdf, nil, font, nil)
+
+		// Act
+		lines := sut.GetLines("two words", prop, 10)
+
+		// Assert
+		assert.Equal(t, []string{"two words"}, lines)
+	})
+	t.Run("when text does not fit the width, should break it in the spaces", func(t *testing.T) {
+		// Arrange
+		prop := &props.Text{Family: fontfamily.Helvetica, Size: 10, BreakLineStrategy: breakline.EmptySpaceStrategy}
+
+		pdf := &mocks.Fpdf{}
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(value string) string { return value })
+		pdf.EXPECT().GetStringWidth("two words").Return(12.0)
+		pdf.EXPECT().GetStringWidth("two ").Return(6.0)
+		pdf.EXPECT().GetStringWidth("words ").Return(6.0)
+
+		font := &mocks.Font{}
+		font.EXPECT().SetFont(prop.Family, prop.Style, prop.Size)
+
+		sut := gofpdf.NewText(pdf, nil, font, nil)
+
+		// Act
+		lines := sut.GetLines("two words", prop, 10)
+
+		// Assert
+		assert.Equal(t, []string{"two ", "words "}, lines)
+	})
+}
+
 /*func TestText_GetLinesQuantity_WhenStringSmallerThanLimits(t *testing.T) {
 	// Arrange
 	pdf := &mocks.Fpdf{}
@@ -28,7 +132,7 @@ func TestNewText(t *testing.T) {
 	fontstyle := &mocks.DefaultFont{}
 	fontstyle.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-	sut := internal.NewText(pdf, nil, fontstyle)
+	sut := internal.NewText(pdf, nil, fontstyle, nil)
 
 	// Act
 	lines := sut.GetLinesQuantity("AnyText With Spaces", props.Text{}, 2)
@@ -48,7 +152,7 @@ func TestText_GetLinesQuantity_WhenHasOneWord(t *testing.T) {
 	fontstyle := &mocks.DefaultFont{}
 	fontstyle.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-	sut := internal.NewText(pdf, nil, fontstyle)
+	sut := internal.NewText(pdf, nil, fontstyle, nil)
 
 	// Act
 	lines := sut.GetLinesQuantity("OneWord", props.Text{}, 2)
@@ -68,7 +172,7 @@ func TestText_GetLinesQuantity_WhenExtrapolate(t *testing.T) {
 	fontstyle := &mocks.DefaultFont{}
 	fontstyle.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-	sut := internal.NewText(pdf, nil, fontstyle)
+	sut := internal.NewText(pdf, nil, fontstyle, nil)
 
 	// Act
 	lines := sut.GetLinesQuantity("Many words", props.Text{Extrapolate: true}, 2)
@@ -91,7 +195,7 @@ func TestText_GetLinesQuantity_WhenHasToBreakLines(t *testing.T) {
 	fontstyle := &mocks.DefaultFont{}
 	fontstyle.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-	sut := internal.NewText(pdf, math, fontstyle)
+	sut := internal.NewText(pdf, math, fontstyle, nil)
 
 	// Act
 	lines := sut.GetLinesQuantity("Many words", props.Text{}, 2)
@@ -497,7 +601,7 @@ func TestText_Add(t *testing.T) {
 		_pdf := c.pdf()
 		_font := c.fontstyle()
 
-		text := internal.NewText(_pdf, nil, _font)
+		text := internal.NewText(_pdf, nil, _font, nil)
 
 		var cell internal.Cell
 		if c.cell() == nil {
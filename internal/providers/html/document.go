@@ -0,0 +1,241 @@
+// Package html implements a canvas which draws an HTML document with one SVG
+// per page, which can be used as a preview of the PDF in browsers.
+package html
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontfamily"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontstyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/linestyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+type document struct {
+	customFonts []*entity.CustomFont
+	metadata    *entity.Metadata
+	pages       []*page
+}
+
+// page is a page of the document drawn as a SVG, where the unit
+// of the coordinates is the millimeter, as in the PDF.
+type page struct {
+	width   float64
+	height  float64
+	content strings.Builder
+}
+
+// New create a html document, where the custom fonts are embedded
+// to draw the texts with the fonts of the PDF.
+func New(customFonts []*entity.CustomFont) *document {
+	return &document{
+		customFonts: customFonts,
+	}
+}
+
+// AddPage adds a new page, where the next elements are drawn.
+func (d *document) AddPage(width, height float64) {
+	d.pages = append(d.pages, &page{
+		width:  width,
+		height: height,
+	})
+}
+
+// AddRect draws a rectangle filled with the color.
+func (d *document) AddRect(x, y, width, height float64, color *props.Color) {
+	fmt.Fprintf(&d.currentPage().content, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"/>`,
+		format(x), format(y), format(width), format(height), getColor(color))
+}
+
+// AddLine draws a straight line between two points.
+func (d *document) AddLine(x1, y1, x2, y2 float64, prop *props.Line) {
+	p := d.currentPage()
+
+	fmt.Fprintf(&p.content, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s" stroke-width="%s"`,
+		format(x1), format(y1), format(x2), format(y2), getColor(prop.Color), format(prop.Thickness))
+
+	if prop.Style == linestyle.Dashed {
+		p.content.WriteString(` stroke-dasharray="1 1"`)
+	}
+
+	p.content.WriteString("/>")
+}
+
+// AddText draws a line of text, where y is the position of the baseline. The
+// text is only wrapped in the link when the link is safe to be opened.
+func (d *document) AddText(x, y float64, text string, fontSize float64, prop *props.Text, link string) {
+	p := d.currentPage()
+	hasLink := isSafeLink(link)
+
+	if hasLink {
+		fmt.Fprintf(&p.content, `<a href="%s">`, html.EscapeString(link))
+	}
+
+	fmt.Fprintf(&p.content, `<text x="%s" y="%s" font-family="%s" font-size="%s" fill="%s" xml:space="preserve"`,
+		format(x), format(y), html.EscapeString(getFontFamily(prop.Family)), format(fontSize), getColor(prop.Color))
+
+	if prop.Style == fontstyle.Bold || prop.Style == fontstyle.BoldItalic {
+		p.content.WriteString(` font-weight="bold"`)
+	}
+
+	if prop.Style == fontstyle.Italic || prop.Style == fontstyle.BoldItalic {
+		p.content.WriteString(` font-style="italic"`)
+	}
+
+	fmt.Fprintf(&p.content, ">%s</text>", html.EscapeString(text))
+
+	if hasLink {
+		p.content.WriteString("</a>")
+	}
+}
+
+// AddImage draws an image, embedding its bytes in the document.
+func (d *document) AddImage(x, y, width, height float64, img *entity.Image) error {
+	mime := "image/jpeg"
+	if img.Extension == extension.Png {
+		mime = "image/png"
+	}
+
+	fmt.Fprintf(&d.currentPage().content, `<image x="%s" y="%s" width="%s" height="%s" preserveAspectRatio="none" href="data:%s;base64,%s"/>`,
+		format(x), format(y), format(width), format(height), mime, base64.StdEncoding.EncodeToString(img.Bytes))
+	return nil
+}
+
+// AddDestination adds an element which is the target of internal links.
+func (d *document) AddDestination(x, y float64, name string) {
+	fmt.Fprintf(&d.currentPage().content, `<rect id="%s" x="%s" y="%s" width="0" height="0"/>`,
+		html.EscapeString(name), format(x), format(y))
+}
+
+// BeginTransform groups the next elements, which are rotated counter-clockwise
+// by angle degrees around x and y and drawn with the opacity, until EndTransform.
+func (d *document) BeginTransform(x, y, angle, opacity float64) {
+	fmt.Fprintf(&d.currentPage().content, `<g transform="rotate(%s %s %s)" opacity="%s">`,
+		format(-angle), format(x), format(y), format(opacity))
+}
+
+// EndTransform closes the group started by BeginTransform.
+func (d *document) EndTransform() {
+	d.currentPage().content.WriteString("</g>")
+}
+
+// SetMetadata defines the metadata added to the head of the document.
+func (d *document) SetMetadata(metadata *entity.Metadata) {
+	d.metadata = metadata
+}
+
+// Write writes the HTML document with all pages to w.
+func (d *document) Write(w io.Writer) error {
+	var buffer bytes.Buffer
+	buffer.WriteString(`<!DOCTYPE html><html><head><meta charset="utf-8">`)
+	d.writeMetadata(&buffer)
+	d.writeStyle(&buffer)
+	buffer.WriteString("</head><body>")
+
+	for _, p := range d.pages {
+		fmt.Fprintf(&buffer, `<svg xmlns="http://www.w3.org/2000/svg" width="%smm" height="%smm" viewBox="0 0 %s %s">%s</svg>`,
+			format(p.width), format(p.height), format(p.width), format(p.height), p.content.String())
+	}
+
+	buffer.WriteString("</body></html>")
+
+	_, err := buffer.WriteTo(w)
+	return err
+}
+
+func (d *document) currentPage() *page {
+	return d.pages[len(d.pages)-1]
+}
+
+func (d *document) writeMetadata(w io.Writer) {
+	if d.metadata == nil {
+		return
+	}
+
+	if d.metadata.Title != nil {
+		fmt.Fprintf(w, "<title>%s</title>", html.EscapeString(d.metadata.Title.Text))
+	}
+
+	if d.metadata.Author != nil {
+		fmt.Fprintf(w, `<meta name="author" content="%s">`, html.EscapeString(d.metadata.Author.Text))
+	}
+
+	if d.metadata.Subject != nil {
+		fmt.Fprintf(w, `<meta name="description" content="%s">`, html.EscapeString(d.metadata.Subject.Text))
+	}
+
+	if d.metadata.Creator != nil {
+		fmt.Fprintf(w, `<meta name="generator" content="%s">`, html.EscapeString(d.metadata.Creator.Text))
+	}
+}
+
+// writeStyle embeds the custom fonts, so texts are drawn with the fonts of the PDF.
+func (d *document) writeStyle(w io.Writer) {
+	fmt.Fprint(w, "<style>body{margin:0;background:#e0e0e0}svg{display:block;margin:8px auto;background:#fff}")
+
+	for _, font := range d.customFonts {
+		weight, style := "normal", "normal"
+		if font.Style == fontstyle.Bold || font.Style == fontstyle.BoldItalic {
+			weight = "bold"
+		}
+
+		if font.Style == fontstyle.Italic || font.Style == fontstyle.BoldItalic {
+			style = "italic"
+		}
+
+		fmt.Fprintf(w, `@font-face{font-family:"%s";font-weight:%s;font-style:%s;src:url(data:font/ttf;base64,%s)}`,
+			html.EscapeString(font.Family), weight, style, base64.StdEncoding.EncodeToString(font.Bytes))
+	}
+
+	fmt.Fprint(w, "</style>")
+}
+
+// isSafeLink returns true when the link is a fragment of the document or
+// an URL with the http, https or mailto schemes, which cannot run scripts.
+func isSafeLink(link string) bool {
+	if strings.HasPrefix(link, "#") {
+		return true
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	return u.Scheme == "http" || u.Scheme == "https" || u.Scheme == "mailto"
+}
+
+func getFontFamily(family string) string {
+	switch family {
+	case fontfamily.Arial, fontfamily.Helvetica:
+		return "Helvetica, Arial, sans-serif"
+	case fontfamily.Courier:
+		return "Courier, monospace"
+	case fontfamily.Symbol:
+		return "Symbol"
+	case fontfamily.ZapBats:
+		return "ZapfDingbats"
+	default:
+		return family
+	}
+}
+
+func getColor(color *props.Color) string {
+	if color == nil {
+		color = &props.BlackColor
+	}
+
+	return fmt.Sprintf("rgb(%d,%d,%d)", color.Red, color.Green, color.Blue)
+}
+
+func format(value float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.3f", value), "0"), ".")
+}
@@ -0,0 +1,242 @@
+package html_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/internal/fixture"
+	"github.com/miguelbernadi/maroto/v2/internal/providers/html"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontstyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/linestyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+func TestNew(t *testing.T) {
+	// Act
+	sut := html.New(nil)
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*html.document", fmt.Sprintf("%T", sut))
+}
+
+func TestDocument_Write(t *testing.T) {
+	t.Run("when there are pages, should write one svg per page", func(t *testing.T) {
+		// Arrange
+		sut := html.New(nil)
+		sut.AddPage(210, 297)
+		sut.AddPage(210, 297)
+		var buffer bytes.Buffer
+
+		// Act
+		err := sut.Write(&buffer)
+
+		// Assert
+		assert.Nil(t, err)
+		document := buffer.String()
+		assert.True(t, strings.HasPrefix(document, "<!DOCTYPE html>"))
+		assert.Equal(t, 2, strings.Count(document, "<svg"))
+		assert.Contains(t, document, `width="210mm" height="297mm" viewBox="0 0 210 297"`)
+	})
+	t.Run("when metadata is set, should add it to the head", func(t *testing.T) {
+		// Arrange
+		sut := html.New(nil)
+		sut.AddPage(210, 297)
+		sut.SetMetadata(&entity.Metadata{
+			Title:  &entity.Utf8Text{Text: "<invoice>"},
+			Author: &entity.Utf8Text{Text: "author"},
+		})
+		var buffer bytes.Buffer
+
+		// Act
+		err := sut.Write(&buffer)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, buffer.String(), "<title>&lt;invoice&gt;</title>")
+		assert.Contains(t, buffer.String(), `<meta name="author" content="author">`)
+	})
+	t.Run("when there are custom fonts, should embed them", func(t *testing.T) {
+		// Arrange
+		sut := html.New([]*entity.CustomFont{{Family: "roboto", Style: fontstyle.Bold, Bytes: []byte{1, 2, 3}}})
+		sut.AddPage(210, 297)
+		var buffer bytes.Buffer
+
+		// Act
+		err := sut.Write(&buffer)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, buffer.String(), `@font-face{font-family:"roboto";font-weight:bold;font-style:normal;src:url(data:font/ttf;base64,AQID)}`)
+	})
+}
+
+func TestDocument_AddRect(t *testing.T) {
+	// Arrange
+	sut := html.New(nil)
+	sut.AddPage(210, 297)
+	var buffer bytes.Buffer
+
+	// Act
+	sut.AddRect(10, 10.5, 50, 20, &props.Color{Red: 255, Green: 100, Blue: 50})
+
+	// Assert
+	assert.Nil(t, sut.Write(&buffer))
+	assert.Contains(t, buffer.String(), `<rect x="10" y="10.5" width="50" height="20" fill="rgb(255,100,50)"/>`)
+}
+
+func TestDocument_AddLine(t *testing.T) {
+	t.Run("when line is solid, should draw a line", func(t *testing.T) {
+		// Arrange
+		sut := html.New(nil)
+		sut.AddPage(210, 297)
+		var buffer bytes.Buffer
+
+		// Act
+		sut.AddLine(10, 10, 10, 30, &props.Line{Thickness: 0.6})
+
+		// Assert
+		assert.Nil(t, sut.Write(&buffer))
+		assert.Contains(t, buffer.String(), `<line x1="10" y1="10" x2="10" y2="30" stroke="rgb(0,0,0)" stroke-width="0.6"/>`)
+	})
+	t.Run("when line is dashed, should draw a dashed line", func(t *testing.T) {
+		// Arrange
+		sut := html.New(nil)
+		sut.AddPage(210, 297)
+		var buffer bytes.Buffer
+		prop := fixture.LineProp()
+		prop.Style = linestyle.Dashed
+
+		// Act
+		sut.AddLine(10, 10, 10, 30, &prop)
+
+		// Assert
+		assert.Nil(t, sut.Write(&buffer))
+		assert.Contains(t, buffer.String(), `stroke-dasharray="1 1"`)
+	})
+}
+
+func TestDocument_AddText(t *testing.T) {
+	t.Run("when text has no link, should draw only the text", func(t *testing.T) {
+		// Arrange
+		sut := html.New(nil)
+		sut.AddPage(210, 297)
+		prop := fixture.TextProp()
+		var buffer bytes.Buffer
+
+		// Act
+		sut.AddText(10, 20, "<text>", 4.9, &prop, "")
+
+		// Assert
+		assert.Nil(t, sut.Write(&buffer))
+		assert.Contains(t, buffer.String(), `<text x="10" y="20" font-family="Helvetica, Arial, sans-serif" font-size="4.9"`)
+		assert.Contains(t, buffer.String(), `font-weight="bold">&lt;text&gt;</text>`)
+		assert.NotContains(t, buffer.String(), "<a ")
+	})
+	t.Run("when text has a link, should wrap the text in the link", func(t *testing.T) {
+		// Arrange
+		sut := html.New(nil)
+		sut.AddPage(210, 297)
+		prop := fixture.TextProp()
+		var buffer bytes.Buffer
+
+		// Act
+		sut.AddText(10, 20, "text", 4.9, &prop, "#summary")
+
+		// Assert
+		assert.Nil(t, sut.Write(&buffer))
+		assert.Contains(t, buffer.String(), `<a href="#summary"><text`)
+		assert.Contains(t, buffer.String(), "</text></a>")
+	})
+	t.Run("when text has an URL link, should wrap the text in the link", func(t *testing.T) {
+		// Arrange
+		sut := html.New(nil)
+		sut.AddPage(210, 297)
+		prop := fixture.TextProp()
+		var buffer bytes.Buffer
+
+		// Act
+		sut.AddText(10, 20, "text", 4.9, &prop, "HTTPS://example.com/?a=1&b=2")
+
+		// Assert
+		assert.Nil(t, sut.Write(&buffer))
+		assert.Contains(t, buffer.String(), `<a href="HTTPS://example.com/?a=1&amp;b=2"><text`)
+	})
+	t.Run("when text has a link which can run scripts, should draw only the text", func(t *testing.T) {
+		// Arrange
+		links := []string{
+			"javascript:alert(1)",
+			"JavaScript:alert(1)",
+			" javascript:alert(1)",
+			"data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==",
+			"vbscript:msgbox(1)",
+		}
+
+		for _, link := range links {
+			sut := html.New(nil)
+			sut.AddPage(210, 297)
+			prop := fixture.TextProp()
+			var buffer bytes.Buffer
+
+			// Act
+			sut.AddText(10, 20, "text", 4.9, &prop, link)
+
+			// Assert
+			assert.Nil(t, sut.Write(&buffer))
+			assert.NotContains(t, buffer.String(), "<a ", link)
+			assert.Contains(t, buffer.String(), ">text</text>", link)
+		}
+	})
+}
+
+func TestDocument_AddImage(t *testing.T) {
+	// Arrange
+	sut := html.New(nil)
+	sut.AddPage(210, 297)
+	var buffer bytes.Buffer
+
+	// Act
+	err := sut.AddImage(10, 20, 30, 40, &entity.Image{Bytes: []byte{1, 2, 3}, Extension: extension.Png})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Nil(t, sut.Write(&buffer))
+	assert.Contains(t, buffer.String(), `<image x="10" y="20" width="30" height="40" preserveAspectRatio="none" href="data:image/png;base64,AQID"/>`)
+}
+
+func TestDocument_BeginTransform(t *testing.T) {
+	// Arrange
+	sut := html.New(nil)
+	sut.AddPage(210, 297)
+	var buffer bytes.Buffer
+
+	// Act
+	sut.BeginTransform(105, 148.5, 45, 0.3)
+	sut.AddRect(10, 20, 30, 40, &props.RedColor)
+	sut.EndTransform()
+
+	// Assert
+	assert.Nil(t, sut.Write(&buffer))
+	assert.Contains(t, buffer.String(), `<g transform="rotate(-45 105 148.5)" opacity="0.3"><rect `)
+	assert.Contains(t, buffer.String(), `/></g></svg>`)
+}
+
+func TestDocument_AddDestination(t *testing.T) {
+	// Arrange
+	sut := html.New(nil)
+	sut.AddPage(210, 297)
+	var buffer bytes.Buffer
+
+	// Act
+	sut.AddDestination(10, 20, "summary")
+
+	// Assert
+	assert.Nil(t, sut.Write(&buffer))
+	assert.Contains(t, buffer.String(), `<rect id="summary" x="10" y="20" width="0" height="0"/>`)
+}
@@ -0,0 +1,321 @@
+// Package png implements a canvas which draws each page as a PNG image with
+// pure Go rasterization, which can be used for thumbnails and visual tests.
+package png
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	goimage "image"
+	"image/color"
+	"image/draw"
+	gopng "image/png"
+	"io"
+	"math"
+
+	// Registers the decoder of jpg images.
+	_ "image/jpeg"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/gobolditalic"
+	"golang.org/x/image/font/gofont/goitalic"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/gomonobold"
+	"golang.org/x/image/font/gofont/gomonobolditalic"
+	"golang.org/x/image/font/gofont/gomonoitalic"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/f64"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontfamily"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontstyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/linestyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+// DPI is the resolution in which the pages are drawn.
+const DPI = 96.0
+
+const (
+	millimetersPerInch = 25.4
+	// dashSize is the size of the dashes and gaps of dashed lines, as in gofpdf.
+	dashSize = 1.0
+)
+
+// standardFonts replaces the fonts of the PDF, which are not embedded
+// in the document, with Go fonts of similar metrics.
+var standardFonts = map[fontKey][]byte{
+	{family: fontfamily.Arial, style: fontstyle.Normal}:       goregular.TTF,
+	{family: fontfamily.Arial, style: fontstyle.Bold}:         gobold.TTF,
+	{family: fontfamily.Arial, style: fontstyle.Italic}:       goitalic.TTF,
+	{family: fontfamily.Arial, style: fontstyle.BoldItalic}:   gobolditalic.TTF,
+	{family: fontfamily.Courier, style: fontstyle.Normal}:     gomono.TTF,
+	{family: fontfamily.Courier, style: fontstyle.Bold}:       gomonobold.TTF,
+	{family: fontfamily.Courier, style: fontstyle.Italic}:     gomonoitalic.TTF,
+	{family: fontfamily.Courier, style: fontstyle.BoldItalic}: gomonobolditalic.TTF,
+}
+
+type fontKey struct {
+	family string
+	style  fontstyle.Type
+}
+
+type faceKey struct {
+	fontKey
+	size float64
+}
+
+type document struct {
+	// scale is the quantity of pixels in one millimeter.
+	scale float64
+	fonts map[fontKey]*opentype.Font
+	faces map[faceKey]font.Face
+	pages []*goimage.RGBA
+	// layer is a transparent image where the elements are drawn between
+	// BeginTransform and EndTransform, to be transformed over the page.
+	layer     *goimage.RGBA
+	transform transform
+}
+
+// transform is the rotation, in degrees counter-clockwise around x and y
+// in pixels, and the opacity applied to a layer.
+type transform struct {
+	x       float64
+	y       float64
+	angle   float64
+	opacity float64
+}
+
+// New create a png document, where the custom fonts are used to draw the texts
+// with the fonts of the PDF. Other fonts are replaced by Go fonts.
+func New(customFonts []*entity.CustomFont) *document {
+	d := &document{
+		scale: DPI / millimetersPerInch,
+		fonts: make(map[fontKey]*opentype.Font),
+		faces: make(map[faceKey]font.Face),
+	}
+
+	for _, customFont := range customFonts {
+		parsed, err := opentype.Parse(customFont.Bytes)
+		if err != nil {
+			continue
+		}
+
+		d.fonts[fontKey{family: customFont.Family, style: customFont.Style}] = parsed
+	}
+
+	return d
+}
+
+// AddPage adds a new white page, where the next elements are drawn.
+func (d *document) AddPage(width, height float64) {
+	page := goimage.NewRGBA(goimage.Rect(0, 0, d.toPixels(width), d.toPixels(height)))
+	draw.Draw(page, page.Bounds(), goimage.White, goimage.Point{}, draw.Src)
+
+	d.pages = append(d.pages, page)
+}
+
+// AddRect draws a rectangle filled with the color.
+func (d *document) AddRect(x, y, width, height float64, color *props.Color) {
+	rect := goimage.Rect(d.toPixels(x), d.toPixels(y), d.toPixels(x+width), d.toPixels(y+height))
+	draw.Draw(d.currentPage(), rect, goimage.NewUniform(getColor(color)), goimage.Point{}, draw.Over)
+}
+
+// AddLine draws a straight line between two points.
+func (d *document) AddLine(x1, y1, x2, y2 float64, prop *props.Line) {
+	if prop.Style != linestyle.Dashed {
+		d.drawLine(x1, y1, x2, y2, prop)
+		return
+	}
+
+	length := math.Hypot(x2-x1, y2-y1)
+	if length == 0 {
+		return
+	}
+
+	dx, dy := (x2-x1)/length, (y2-y1)/length
+	for start := 0.0; start < length; start += 2 * dashSize {
+		end := math.Min(start+dashSize, length)
+		d.drawLine(x1+dx*start, y1+dy*start, x1+dx*end, y1+dy*end, prop)
+	}
+}
+
+// AddText draws a line of text, where y is the position of the baseline.
+// Links cannot be followed in images, so they are only drawn as texts.
+func (d *document) AddText(x, y float64, text string, fontSize float64, prop *props.Text, _ string) {
+	drawer := font.Drawer{
+		Dst:  d.currentPage(),
+		Src:  goimage.NewUniform(getColor(prop.Color)),
+		Face: d.getFace(prop.Family, prop.Style, fontSize),
+		Dot:  fixed.Point26_6{X: d.toFixed(x), Y: d.toFixed(y)},
+	}
+
+	drawer.DrawString(text)
+}
+
+// AddImage draws an image scaled to the rectangle.
+func (d *document) AddImage(x, y, width, height float64, img *entity.Image) error {
+	src, _, err := goimage.Decode(bytes.NewReader(img.Bytes))
+	if err != nil {
+		return err
+	}
+
+	rect := goimage.Rect(d.toPixels(x), d.toPixels(y), d.toPixels(x+width), d.toPixels(y+height))
+	xdraw.ApproxBiLinear.Scale(d.currentPage(), rect, src, src.Bounds(), xdraw.Over, nil)
+	return nil
+}
+
+// AddDestination does nothing, as links cannot be followed in images.
+func (d *document) AddDestination(float64, float64, string) {}
+
+// BeginTransform draws the next elements in a layer, which is rotated counter-clockwise
+// by angle degrees around x and y and drawn with the opacity when EndTransform is called.
+func (d *document) BeginTransform(x, y, angle, opacity float64) {
+	d.layer = goimage.NewRGBA(d.currentPage().Bounds())
+	d.transform = transform{x: x * d.scale, y: y * d.scale, angle: angle, opacity: opacity}
+}
+
+// EndTransform draws the layer started by BeginTransform over the page.
+func (d *document) EndTransform() {
+	if d.layer == nil {
+		return
+	}
+
+	layer := d.layer
+	d.layer = nil
+
+	// As the colors are alpha-premultiplied, every channel is multiplied by the opacity.
+	for i := range layer.Pix {
+		layer.Pix[i] = uint8(float64(layer.Pix[i]) * d.transform.opacity)
+	}
+
+	// The y axis points down, so the rotation is inverted to be counter-clockwise.
+	sin, cos := math.Sincos(d.transform.angle * math.Pi / 180)
+	x, y := d.transform.x, d.transform.y
+	matrix := f64.Aff3{
+		cos, sin, x - x*cos - y*sin,
+		-sin, cos, y + x*sin - y*cos,
+	}
+
+	xdraw.BiLinear.Transform(d.currentPage(), matrix, layer, layer.Bounds(), xdraw.Over, nil)
+}
+
+// SetMetadata does nothing, as the metadata is not kept in the images.
+func (d *document) SetMetadata(*entity.Metadata) {}
+
+// Write writes a ZIP archive to w with one PNG image per page,
+// named page-1.png, page-2.png and so on.
+func (d *document) Write(w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	for i, page := range d.pages {
+		file, err := archive.Create(fmt.Sprintf("page-%d.png", i+1))
+		if err != nil {
+			return err
+		}
+
+		if err = gopng.Encode(file, page); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+// drawLine draws a line as a rectangle around the segment, which is
+// rasterized only in the pixels around the line.
+func (d *document) drawLine(x1, y1, x2, y2 float64, prop *props.Line) {
+	x1, y1, x2, y2 = x1*d.scale, y1*d.scale, x2*d.scale, y2*d.scale
+
+	length := math.Hypot(x2-x1, y2-y1)
+	if length == 0 {
+		return
+	}
+
+	half := math.Max(prop.Thickness*d.scale, 1) / 2
+	nx, ny := -(y2-y1)/length*half, (x2-x1)/length*half
+
+	points := [][2]float64{{x1 + nx, y1 + ny}, {x2 + nx, y2 + ny}, {x2 - nx, y2 - ny}, {x1 - nx, y1 - ny}}
+
+	minX, minY := math.Floor(math.Min(x1, x2)-half), math.Floor(math.Min(y1, y2)-half)
+	maxX, maxY := math.Ceil(math.Max(x1, x2)+half), math.Ceil(math.Max(y1, y2)+half)
+
+	rasterizer := vector.NewRasterizer(int(maxX-minX), int(maxY-minY))
+	rasterizer.MoveTo(float32(points[0][0]-minX), float32(points[0][1]-minY))
+	for _, point := range points[1:] {
+		rasterizer.LineTo(float32(point[0]-minX), float32(point[1]-minY))
+	}
+	rasterizer.ClosePath()
+
+	rect := goimage.Rect(int(minX), int(minY), int(maxX), int(maxY))
+	rasterizer.Draw(d.currentPage(), rect, goimage.NewUniform(getColor(prop.Color)), goimage.Point{})
+}
+
+func (d *document) getFace(family string, style fontstyle.Type, fontSize float64) font.Face {
+	key := faceKey{fontKey: fontKey{family: family, style: style}, size: fontSize}
+	if face, ok := d.faces[key]; ok {
+		return face
+	}
+
+	// As the DPI of the face is 72, the size in points is the size in pixels.
+	face, err := opentype.NewFace(d.getFont(key.fontKey), &opentype.FaceOptions{
+		Size:    fontSize * d.scale,
+		DPI:     72,
+		Hinting: font.HintingNone,
+	})
+	if err != nil {
+		face = basicfont.Face7x13
+	}
+
+	d.faces[key] = face
+	return face
+}
+
+func (d *document) getFont(key fontKey) *opentype.Font {
+	if parsed, ok := d.fonts[key]; ok {
+		return parsed
+	}
+
+	ttf, ok := standardFonts[key]
+	if !ok {
+		ttf = standardFonts[fontKey{family: fontfamily.Arial, style: key.style}]
+	}
+
+	if ttf == nil {
+		ttf = goregular.TTF
+	}
+
+	parsed, _ := opentype.Parse(ttf)
+	d.fonts[key] = parsed
+	return parsed
+}
+
+func (d *document) currentPage() *goimage.RGBA {
+	if d.layer != nil {
+		return d.layer
+	}
+
+	return d.pages[len(d.pages)-1]
+}
+
+func (d *document) toPixels(value float64) int {
+	return int(math.Round(value * d.scale))
+}
+
+func (d *document) toFixed(value float64) fixed.Int26_6 {
+	return fixed.Int26_6(math.Round(value * d.scale * 64))
+}
+
+func getColor(c *props.Color) color.Color {
+	if c == nil {
+		c = &props.BlackColor
+	}
+
+	return color.RGBA{R: uint8(c.Red), G: uint8(c.Green), B: uint8(c.Blue), A: 255}
+}
@@ -0,0 +1,203 @@
+package png_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	gopng "image/png"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/internal/fixture"
+	"github.com/miguelbernadi/maroto/v2/internal/providers/png"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/linestyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+var (
+	white = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	black = color.RGBA{A: 255}
+	red   = color.RGBA{R: 255, A: 255}
+)
+
+// readPages writes the document and decodes the images of its pages.
+func readPages(t *testing.T, sut interface{ Write(w io.Writer) error }) []image.Image {
+	var buffer bytes.Buffer
+	assert.Nil(t, sut.Write(&buffer))
+
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	assert.Nil(t, err)
+
+	pages := make([]image.Image, 0, len(archive.File))
+	for i, file := range archive.File {
+		assert.Equal(t, fmt.Sprintf("page-%d.png", i+1), file.Name)
+
+		reader, err := file.Open()
+		assert.Nil(t, err)
+
+		page, err := gopng.Decode(reader)
+		assert.Nil(t, err)
+		pages = append(pages, page)
+	}
+
+	return pages
+}
+
+// getColor returns the color of a page in a position in millimeters.
+func getColor(page image.Image, x, y float64) color.RGBA {
+	r, g, b, a := page.At(int(x*png.DPI/25.4), int(y*png.DPI/25.4)).RGBA()
+	return color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: uint8(a >> 8)}
+}
+
+func TestNew(t *testing.T) {
+	// Act
+	sut := png.New(nil)
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*png.document", fmt.Sprintf("%T", sut))
+}
+
+func TestDocument_Write(t *testing.T) {
+	// Arrange
+	sut := png.New(nil)
+	sut.AddPage(210, 297)
+	sut.AddPage(100, 50)
+
+	// Act
+	pages := readPages(t, sut)
+
+	// Assert
+	assert.Len(t, pages, 2)
+	assert.Equal(t, image.Rect(0, 0, 794, 1123), pages[0].Bounds())
+	assert.Equal(t, image.Rect(0, 0, 378, 189), pages[1].Bounds())
+	assert.Equal(t, white, getColor(pages[0], 105, 150))
+}
+
+func TestDocument_AddRect(t *testing.T) {
+	// Arrange
+	sut := png.New(nil)
+	sut.AddPage(210, 297)
+
+	// Act
+	sut.AddRect(10, 10, 50, 20, &props.RedColor)
+
+	// Assert
+	page := readPages(t, sut)[0]
+	assert.Equal(t, red, getColor(page, 35, 20))
+	assert.Equal(t, white, getColor(page, 65, 20))
+}
+
+func TestDocument_BeginTransform(t *testing.T) {
+	// Arrange
+	sut := png.New(nil)
+	sut.AddPage(210, 297)
+
+	// Act
+	sut.BeginTransform(35, 20, 90, 0.5)
+	sut.AddRect(10, 10, 50, 20, &props.RedColor)
+	sut.EndTransform()
+
+	// Assert
+	page := readPages(t, sut)[0]
+	rotated := getColor(page, 35, 40)
+	assert.Equal(t, uint8(255), rotated.R)
+	assert.InDelta(t, 128, rotated.G, 2)
+	assert.InDelta(t, 128, rotated.B, 2)
+	assert.Equal(t, white, getColor(page, 55, 20))
+}
+
+func TestDocument_AddLine(t *testing.T) {
+	t.Run("when line is solid, should draw the whole line", func(t *testing.T) {
+		// Arrange
+		sut := png.New(nil)
+		sut.AddPage(210, 297)
+
+		// Act
+		sut.AddLine(10, 20, 100, 20, &props.Line{Thickness: 1})
+
+		// Assert
+		page := readPages(t, sut)[0]
+		assert.Equal(t, black, getColor(page, 10.5, 20))
+		assert.Equal(t, black, getColor(page, 11.5, 20))
+		assert.Equal(t, white, getColor(page, 50, 22))
+	})
+	t.Run("when line is dashed, should draw dashes with gaps", func(t *testing.T) {
+		// Arrange
+		sut := png.New(nil)
+		sut.AddPage(210, 297)
+		prop := fixture.LineProp()
+		prop.Color = &props.RedColor
+		prop.Thickness = 1
+		prop.Style = linestyle.Dashed
+
+		// Act
+		sut.AddLine(10, 20, 100, 20, &prop)
+
+		// Assert
+		page := readPages(t, sut)[0]
+		assert.Equal(t, red, getColor(page, 10.5, 20))
+		assert.Equal(t, white, getColor(page, 11.5, 20))
+	})
+}
+
+func TestDocument_AddText(t *testing.T) {
+	// Arrange
+	sut := png.New(nil)
+	sut.AddPage(210, 297)
+	prop := fixture.TextProp()
+	prop.Color = &props.RedColor
+
+	// Act
+	sut.AddText(10, 30, "MMMM", 10, &prop, "")
+
+	// Assert
+	page := readPages(t, sut)[0]
+	drawn := false
+	for x := 10.0; x < 40; x += 0.2 {
+		drawn = drawn || getColor(page, x, 28) == red
+	}
+	assert.True(t, drawn)
+	assert.Equal(t, white, getColor(page, 50, 28))
+}
+
+func TestDocument_AddImage(t *testing.T) {
+	t.Run("when image is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := png.New(nil)
+		sut.AddPage(210, 297)
+
+		// Act
+		err := sut.AddImage(10, 20, 30, 40, &entity.Image{Bytes: []byte{1, 2, 3}, Extension: extension.Png})
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when image is valid, should draw it scaled to the rectangle", func(t *testing.T) {
+		// Arrange
+		sut := png.New(nil)
+		sut.AddPage(210, 297)
+		bytes, err := os.ReadFile("../../../docs/assets/images/gopherbw.png")
+		assert.Nil(t, err)
+
+		// Act
+		err = sut.AddImage(10, 20, 100, 100, &entity.Image{Bytes: bytes, Extension: extension.Png})
+
+		// Assert
+		assert.Nil(t, err)
+		page := readPages(t, sut)[0]
+		drawn := false
+		for x := 10.0; x < 110; x++ {
+			drawn = drawn || getColor(page, x, 70) != white
+		}
+		assert.True(t, drawn)
+		assert.Equal(t, white, getColor(page, 150, 70))
+	})
+}
@@ -1,11 +1,22 @@
 package maroto
 
 import (
+	"context"
 	"errors"
+	"io"
+	"maps"
 
 	"github.com/miguelbernadi/maroto/v2/internal/cache"
+	"github.com/miguelbernadi/maroto/v2/internal/pagelabel"
 
+	"github.com/miguelbernadi/maroto/v2/internal/providers/canvas"
 	"github.com/miguelbernadi/maroto/v2/internal/providers/gofpdf"
+	"github.com/miguelbernadi/maroto/v2/internal/providers/html"
+	"github.com/miguelbernadi/maroto/v2/internal/providers/png"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagebreak"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagevariant"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/provider"
 
 	"github.com/miguelbernadi/maroto/v2/pkg/merge"
 
@@ -17,6 +28,7 @@ import (
 
 	"github.com/f-amaral/go-async/async"
 	"github.com/f-amaral/go-async/pool"
+
 	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/page"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
@@ -24,23 +36,80 @@ import (
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
 )
 
+// maxTOCRebuilds is the maximum quantity of times the pages after the table of
+// contents are built again, until the quantity of its pages settles.
+const maxTOCRebuilds = 10
+
 type Maroto struct {
 	config   *entity.Config
 	provider core.Provider
 	cache    cache.Cache
 
 	// Building
+	// cell is the useful area of the pages being built, and pageProp
+	// has the size and orientation of these pages. headers and footers
+	// are the rows registered for each variant, and header and footer
+	// are the rows of the variants of the current page. headerFunc and
+	// footerFunc build the rows of the default variant for each page.
 	cell          entity.Cell
+	pageProp      props.Page
 	pages         []core.Page
 	rows          []core.Row
+	headers       map[pagevariant.Type][]core.Row
+	footers       map[pagevariant.Type][]core.Row
+	headerFunc    func(ctx entity.PageContext) []core.Row
+	footerFunc    func(ctx entity.PageContext) []core.Row
 	header        []core.Row
 	footer        []core.Row
 	headerHeight  float64
 	footerHeight  float64
 	currentHeight float64
+	// dataRows is the quantity of rows added to the document, and firstRow
+	// and lastRow are the indexes of the rows placed in the current page.
+	dataRows int
+	firstRow int
+	lastRow  int
+	anchors  []entity.Anchor
+	// kept are the last rows kept with the next row, which
+	// are held until the next row is added to the document.
+	kept     []core.Row
+	toc      core.TOC
+	tocIndex int
+	// tocState is the state of the document when the table of contents was
+	// added, and tocOperations are the operations which built the pages after
+	// it, which are done again from the state when the quantity of pages of
+	// the table of contents is known.
+	tocState      *Maroto
+	tocOperations []func()
+	// sections are the sections started in the document, ordered by the
+	// index of their first page, and tocSection is the quantity of
+	// sections started before the table of contents.
+	sections   []section
+	tocSection int
+	// closed is true when the last page is closed and the pages of the table
+	// of contents are added, so the pages are not added again when the
+	// document is generated again, e.g. after a canceled generation.
+	closed bool
+	// hasDestinations is true when a row is a destination of internal links,
+	// which are only resolved when all pages are rendered by the same provider.
+	hasDestinations bool
 
 	// Processing
-	pool async.Processor[[]core.Page, []byte]
+	pool async.Processor[pageGroup, []byte]
+}
+
+// pageGroup is a set of pages generated by one worker of the pool.
+type pageGroup struct {
+	ctx   context.Context
+	pages []core.Page
+}
+
+// section is a part of the document with its own page numbering,
+// which starts in the page with the index start.
+type section struct {
+	name      string
+	numbering numbering.Type
+	start     int
 }
 
 // New is responsible for create a new instance of core.Maroto.
@@ -53,19 +122,18 @@ func New(cfgs ...*entity.Config) core.Maroto {
 
 	m := &Maroto{
 		provider: provider,
-		cell: entity.NewRootCell(cfg.Dimensions.Width, cfg.Dimensions.Height, entity.Margins{
-			Left:   cfg.Margins.Left,
-			Top:    cfg.Margins.Top,
-			Right:  cfg.Margins.Right,
-			Bottom: cfg.Margins.Bottom,
-		}),
-		cache:  cache,
-		config: cfg,
+		cell:     getRootCell(cfg, cfg.Dimensions.Width, cfg.Dimensions.Height),
+		cache:    cache,
+		config:   cfg,
+		headers:  make(map[pagevariant.Type][]core.Row),
+		footers:  make(map[pagevariant.Type][]core.Row),
+		firstRow: -1,
+		lastRow:  -1,
 	}
 
 	if cfg.WorkersQuantity > 0 {
-		p := pool.NewPool[[]core.Page, []byte](cfg.WorkersQuantity, m.processPage,
-			pool.WithSortingOutput[[]core.Page, []byte]())
+		p := pool.NewPool[pageGroup, []byte](cfg.WorkersQuantity, m.processPage,
+			pool.WithSortingOutput[pageGroup, []byte]())
 		m.pool = p
 	}
 	return m
@@ -75,24 +143,110 @@ func New(cfgs ...*entity.Config) core.Maroto {
 // By adding a page directly, the current cursor will reset and the
 // new page will appear as the next. If the page provided have
 // more rows than the maximum useful area of a page, maroto will split
-// that page in more than one.
+// that page in more than one. The size and orientation of the page are
+// used in the pages created for its rows and in the next pages, until
+// another page is added.
 func (m *Maroto) AddPages(pages ...core.Page) {
-	for _, page := range pages {
+	m.runOperation(func() {
+		m.addKeptRows()
+
+		for _, page := range pages {
+			newPage := m.currentHeight != m.headerHeight
+			if newPage {
+				m.fillPageToAddNew()
+			}
+
+			m.setPageProp(page.GetProp())
+
+			if newPage {
+				m.addHeader()
+			}
+
+			m.addRows(page.GetRows()...)
+			m.addKeptRows()
+		}
+	})
+}
+
+// AddTOC is responsible for add a table of contents in the current position
+// of the document. The table of contents starts on a new page and lists every
+// row marked with an anchor. As the page of an anchor is only known after all
+// rows are added, the pages of the table of contents are inserted when the
+// document is generated, and the pages after it are built again, so their
+// page numbers count the pages of the table of contents. Only one table of
+// contents is supported, so it returns an error when it is called again.
+func (m *Maroto) AddTOC(toc core.TOC) error {
+	if m.toc != nil {
+		return errors.New("only one table of contents is supported")
+	}
+
+	m.addKeptRows()
+
+	if m.currentHeight != m.headerHeight {
+		m.fillPageToAddNew()
+		m.addHeader()
+	}
+
+	m.closed = false
+	m.toc = toc
+	m.tocIndex = len(m.pages)
+	m.tocSection = len(m.sections)
+	m.tocState, m.tocOperations = m.copyState(), nil
+
+	return nil
+}
+
+// StartSection is responsible for start a section of the document in a new page,
+// where the pages are numbered from 1 with the numbering, e.g. roman numerals in
+// the front matter and decimal numbers restarting in each appendix. The pages
+// before the first section are numbered as decimal numbers. The number of a page
+// in its section replaces {section} in the pattern of the page numbers, and the
+// numbering of the sections is written in the page labels of PDF documents.
+func (m *Maroto) StartSection(name string, numberingStyle numbering.Type) error {
+	if !numberingStyle.IsValid() {
+		return errors.New("invalid numbering")
+	}
+
+	m.runOperation(func() {
+		m.addKeptRows()
+
 		if m.currentHeight != m.headerHeight {
 			m.fillPageToAddNew()
-			m.addHeader()
 		}
-		m.addRows(page.GetRows()...)
+
+		m.sections = append(m.sections, section{name: name, numbering: numberingStyle, start: len(m.pages)})
+		m.replaceHeader()
+	})
+
+	return nil
+}
+
+// AddPageBreak is responsible for start a new page, where the next rows are added.
+// The page break is pagebreak.Next when it is not provided, and with pagebreak.Odd
+// the next rows are added in the next odd page, for duplex printing, adding an
+// empty page before it when needed. The current page is kept when it has no rows.
+func (m *Maroto) AddPageBreak(breakType ...pagebreak.Type) {
+	t := pagebreak.Next
+	if len(breakType) > 0 && breakType[0].IsValid() {
+		t = breakType[0]
 	}
+
+	m.runOperation(func() {
+		m.addKeptRows()
+		m.addPageBreak(t)
+	})
 }
 
 // AddRows is responsible for add rows in the current document.
 // By adding a row, if the row will extrapolate the useful area of a page,
 // maroto will automatically add a new page. Maroto use the information of
 // PageSize, PageMargin, FooterSize and HeaderSize to calculate the useful
-// area of a page.
+// area of a page. When the last row is kept with the next row, it is added
+// with the next row added to the document.
 func (m *Maroto) AddRows(rows ...core.Row) {
-	m.addRows(rows...)
+	m.runOperation(func() {
+		m.addRows(rows...)
+	})
 }
 
 // AddRow is responsible for add one row in the current document.
@@ -102,15 +256,54 @@ func (m *Maroto) AddRows(rows ...core.Row) {
 // area of a page.
 func (m *Maroto) AddRow(rowHeight float64, cols ...core.Col) core.Row {
 	r := row.New(rowHeight).Add(cols...)
-	m.addRow(r)
+	m.runOperation(func() {
+		m.addRows(r)
+	})
+
 	return r
 }
 
+// AddGroup is responsible for add rows which are kept in the same page.
+// When the group does not fit in the useful area of the current page, the
+// whole group is moved to a new page. A group higher than the useful area
+// of a page is split as rows added by AddRows. The rows kept with the next
+// row, which were added before, are kept in the same page of the group.
+func (m *Maroto) AddGroup(rows ...core.Row) {
+	m.runOperation(func() {
+		m.kept = append(m.kept, rows...)
+		m.addKeptRows()
+	})
+}
+
 // FitlnCurrentPage is responsible to validating whether a line fits on
 // the current page.
 func (m *Maroto) FitlnCurrentPage(heightNewLine float64) bool {
-	contentSize := m.getRowsHeight(m.rows...) + m.footerHeight + m.headerHeight
-	return contentSize+heightNewLine < m.config.Dimensions.Height
+	return m.fitsCurrentPage(heightNewLine)
+}
+
+// Cursor is responsible for return the position where the next row is added,
+// with the height available in the current page and the useful area of the
+// page, which are the same used to add rows. The rows held to be kept with the
+// next row are placed as they would be when the document is generated, and the
+// pages of a table of contents are counted with the entries added so far.
+func (m *Maroto) Cursor() entity.Cursor {
+	state := m.copyState()
+	state.addKeptRows()
+
+	pages := len(state.pages)
+	if state.tocState != nil {
+		pages += len(state.paginate(state.toc.GetRows(state.anchors)))
+	}
+
+	return entity.Cursor{
+		Page:            pages,
+		Pages:           pages + 1,
+		RemainingHeight: state.getRemainingHeight(),
+		UsefulArea: entity.Dimensions{
+			Width:  state.cell.Width,
+			Height: state.cell.Height - state.headerHeight - state.footerHeight,
+		},
+	}
 }
 
 // RegisterHeader is responsible to define a set of rows as a header
@@ -118,18 +311,54 @@ func (m *Maroto) FitlnCurrentPage(heightNewLine float64) bool {
 // The header cannot occupy an area greater than the useful area of the page,
 // it this case the method will return an error.
 func (m *Maroto) RegisterHeader(rows ...core.Row) error {
+	return m.RegisterHeaderVariant(pagevariant.Default, rows...)
+}
+
+// RegisterHeaderVariant is responsible to define a set of rows as the header
+// of the pages of a variant, e.g. a large letterhead in the first page. The
+// variants are chosen in the order first, last, odd or even and default, and
+// the space available for the rows of a page is calculated with the height of
+// its own header.
+func (m *Maroto) RegisterHeaderVariant(variant pagevariant.Type, rows ...core.Row) error {
+	if !variant.IsValid() {
+		return errors.New("invalid page variant")
+	}
+
+	m.setRowsConfig(rows...)
 	height := m.getRowsHeight(rows...)
-	if height+m.footerHeight > m.config.Dimensions.Height {
+	if height+m.getRowsHeight(m.getRegisteredFooter(variant)...) > m.config.Dimensions.Height {
 		return errors.New("header height is greater than page useful area")
 	}
 
-	m.headerHeight = height
-	m.header = rows
+	m.runOperation(func() {
+		m.headers[variant] = rows
+		if variant == pagevariant.Default {
+			m.headerFunc = nil
+		}
+
+		m.replaceHeader()
+	})
+
+	return nil
+}
 
-	for _, headerRow := range rows {
-		m.addRow(headerRow)
+// RegisterHeaderFunc is responsible to define a callback which builds the header
+// of each page, replacing the header defined by RegisterHeader. The callback is
+// called with the number of the page while the document is paginated, when the
+// height of its rows is reserved in the page, and it is called again with the
+// total of pages and the rows of the page before the document is generated.
+// The rows should have the same height in both calls.
+func (m *Maroto) RegisterHeaderFunc(build func(ctx entity.PageContext) []core.Row) error {
+	header := m.newPageRows(build)
+	if header.height+m.getRowsHeight(m.getRegisteredFooter(pagevariant.Default)...) > m.config.Dimensions.Height {
+		return errors.New("header height is greater than page useful area")
 	}
 
+	m.runOperation(func() {
+		m.headerFunc = build
+		m.replaceHeader()
+	})
+
 	return nil
 }
 
@@ -138,37 +367,124 @@ func (m *Maroto) RegisterHeader(rows ...core.Row) error {
 // The footer cannot occupy an area greater than the useful area of the page,
 // it this case the method will return an error.
 func (m *Maroto) RegisterFooter(rows ...core.Row) error {
+	return m.RegisterFooterVariant(pagevariant.Default, rows...)
+}
+
+// RegisterFooterVariant is responsible to define a set of rows as the footer
+// of the pages of a variant, e.g. mirrored footers in odd and even pages. The
+// variants are chosen as in RegisterHeaderVariant. When the content of the last
+// page has no space for the footer of the last page, a new page is added with
+// only the header and the footer of the last page.
+func (m *Maroto) RegisterFooterVariant(variant pagevariant.Type, rows ...core.Row) error {
+	if !variant.IsValid() {
+		return errors.New("invalid page variant")
+	}
+
+	m.setRowsConfig(rows...)
 	height := m.getRowsHeight(rows...)
 	if height > m.config.Dimensions.Height {
 		return errors.New("footer height is greater than page useful area")
 	}
 
-	m.footerHeight = height
-	m.footer = rows
+	m.runOperation(func() {
+		m.footers[variant] = rows
+		if variant == pagevariant.Default {
+			m.footerFunc = nil
+		}
+
+		m.footer = m.getPageRows(m.footers, m.footerFunc, false)
+		m.footerHeight = m.getRowsHeight(m.footer...)
+	})
+
+	return nil
+}
+
+// RegisterFooterFunc is responsible to define a callback which builds the footer
+// of each page, replacing the footer defined by RegisterFooter. The callback is
+// called as the callback of RegisterHeaderFunc.
+func (m *Maroto) RegisterFooterFunc(build func(ctx entity.PageContext) []core.Row) error {
+	footer := m.newPageRows(build)
+	if footer.height > m.config.Dimensions.Height {
+		return errors.New("footer height is greater than page useful area")
+	}
+
+	m.runOperation(func() {
+		m.footerFunc = build
+		m.footer = m.getPageRows(m.footers, m.footerFunc, false)
+		m.footerHeight = m.getRowsHeight(m.footer...)
+	})
+
 	return nil
 }
 
 // Generate is responsible to compute the component tree created by
 // the usage of all other Maroto methods, and generate the PDF document.
+// When a row is a destination of internal links, the document is generated
+// sequentially, as links cannot point to pages generated by other workers.
+// The documents of the HTML and PNG providers are not PDFs, so they cannot be merged.
 func (m *Maroto) Generate() (core.Document, error) {
-	m.provider.SetProtection(m.config.Protection)
-	m.provider.SetCompression(m.config.Compression)
-	m.provider.SetMetadata(m.config.Metadata)
+	return m.GenerateContext(context.Background())
+}
 
-	m.fillPageToAddNew()
-	m.setConfig()
+// GenerateContext works like Generate, but stops the generation when ctx is
+// done. The cancellation is checked between pages, also while the pages are
+// generated concurrently, and ctx.Err() is returned. The partially rendered
+// document is released, so a new generation starts from a clean provider.
+func (m *Maroto) GenerateContext(ctx context.Context) (core.Document, error) {
+	if err := m.prepareGeneration(); err != nil {
+		return nil, err
+	}
+
+	documentBytes, err := m.generateBytes(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	if m.config.WorkersQuantity > 0 {
-		return m.generateConcurrently()
+	if !m.isPDF() {
+		return core.NewFile(documentBytes, nil), nil
 	}
 
-	return m.generate()
+	return core.NewPDF(documentBytes, nil), nil
+}
+
+// GenerateTo is responsible to compute the component tree created by
+// the usage of all other Maroto methods, and write the PDF document
+// to w, e.g. an HTTP response or a file, without returning a copy of
+// the document. The document is written directly to w when it is
+// generated sequentially, but it is built in memory before it is
+// written when it is generated concurrently, as the pages of each
+// worker are merged, or when it has sections, as the page labels
+// are added to the complete document.
+func (m *Maroto) GenerateTo(w io.Writer) error {
+	if err := m.prepareGeneration(); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if m.isConcurrent() || m.hasPageLabels() {
+		documentBytes, err := m.generateBytes(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(documentBytes)
+		return err
+	}
+
+	if err := m.render(ctx); err != nil {
+		return err
+	}
+
+	return m.provider.GenerateTo(w)
 }
 
 // GetStructure is responsible for return the component tree, this is useful
 // on unit tests cases.
 func (m *Maroto) GetStructure() *node.Node[core.Structure] {
-	m.fillPageToAddNew()
+	// The pages built before a failure of the table of contents are returned.
+	_ = m.closeDocument()
+	m.buildPageRows()
 
 	str := core.Structure{
 		Type:    "maroto",
@@ -184,45 +500,187 @@ func (m *Maroto) GetStructure() *node.Node[core.Structure] {
 	return node
 }
 
+// addRows adds the rows grouping the rows kept with the next row. The last
+// rows kept with the next row are held until the next row is added.
 func (m *Maroto) addRows(rows ...core.Row) {
-	for _, row := range rows {
-		m.addRow(row)
+	for _, r := range rows {
+		m.kept = append(m.kept, r)
+		if !r.IsKeptWithNext() {
+			m.addKeptRows()
+		}
 	}
 }
 
-func (m *Maroto) addRow(r core.Row) {
-	maxHeight := m.cell.Height
+// addKeptRows adds the rows held to be kept with the next row as a group,
+// e.g. when the document is generated without a row after them.
+func (m *Maroto) addKeptRows() {
+	if len(m.kept) == 0 {
+		return
+	}
 
-	rowHeight := r.GetHeight()
-	sumHeight := rowHeight + m.currentHeight + m.footerHeight
+	rows := m.kept
+	m.kept = nil
+	m.addGroup(rows)
+}
+
+// addGroup moves the rows to a new page when they do not fit in the current
+// page, but fit in a new one, before adding them.
+func (m *Maroto) addGroup(rows []core.Row) {
+	m.addPageBreak(rows[0].GetPageBreakBefore())
+
+	if len(rows) > 1 && m.currentHeight != m.headerHeight {
+		m.setRowsConfig(rows...)
+		groupHeight := m.getRowsHeight(rows...)
 
-	// Row smaller than the remain space on page
-	if sumHeight < maxHeight {
-		m.currentHeight += rowHeight
-		m.rows = append(m.rows, r)
+		if !m.fitsCurrentPage(groupHeight) && m.fitsNewPage(groupHeight) {
+			m.fillPageToAddNew()
+			m.addHeader()
+			m.addRepeatedHeader(rows[0])
+		}
+	}
+
+	for _, r := range rows {
+		m.addDataRow(r)
+	}
+}
+
+// addDataRow adds a row added to the document, which is counted
+// in the first and last rows of the pages.
+func (m *Maroto) addDataRow(r core.Row) {
+	m.addPageBreak(r.GetPageBreakBefore())
+	m.addRow(r)
+	m.dataRows++
+}
+
+func (m *Maroto) addRow(r core.Row) {
+	// The config is needed to measure rows without a fixed height.
+	m.setRowsConfig(r)
+	rowHeight := core.GetRowHeight(r, m.provider, &m.cell)
+	fits := m.fitsCurrentPage(rowHeight)
+
+	// As row is higher than a page, the row is split to
+	// continue the rest of its content in the next pages
+	if !fits && !m.fitsNewPage(rowHeight) && m.splitRow(r, rowHeight, true) {
 		return
 	}
 
 	// As row will extrapolate page, we will add empty space
 	// on the page to force a new page
-	m.fillPageToAddNew()
+	if !fits {
+		m.fillPageToAddNew()
+		m.addHeader()
+		m.addRepeatedHeader(r)
+	}
+
+	m.appendRow(r, rowHeight)
+}
+
+// splitRow adds the part of a row which fits in the current page, and adds the
+// rest of the row in a new page. When nothing fits in the current page and retry
+// is true, the row is split again in a new page. It returns false when the row
+// cannot be split.
+func (m *Maroto) splitRow(r core.Row, rowHeight float64, retry bool) bool {
+	first, rest := r.Split(m.provider, &m.cell, m.getRemainingHeight())
+	if rest == nil {
+		return false
+	}
+
+	m.setRowsConfig(first, rest)
+	if m.getRowsHeight(rest) >= rowHeight {
+		if !retry {
+			return false
+		}
+
+		m.fillPageToAddNew()
+		m.addHeader()
+		m.addRepeatedHeader(r)
 
+		if !m.splitRow(r, rowHeight, false) {
+			m.appendRow(r, rowHeight)
+		}
+
+		return true
+	}
+
+	m.appendRow(first, m.getRowsHeight(first))
+	m.fillPageToAddNew()
 	m.addHeader()
+	m.addRepeatedHeader(rest)
+	m.addRow(rest)
 
-	// AddRows row on the new page
+	return true
+}
+
+func (m *Maroto) appendRow(r core.Row, rowHeight float64) {
 	m.currentHeight += rowHeight
 	m.rows = append(m.rows, r)
+	m.addAnchor(r)
+
+	if m.firstRow < 0 {
+		m.firstRow = m.dataRows
+	}
+	m.lastRow = m.dataRows
+
+	if r.GetDestination() != "" {
+		m.hasDestinations = true
+	}
+}
+
+// addPageBreak starts a new page when the current page has rows, and adds an
+// empty page when the new page is even and the page break is to an odd page.
+func (m *Maroto) addPageBreak(breakType pagebreak.Type) {
+	if breakType == "" {
+		return
+	}
+
+	if m.currentHeight != m.headerHeight {
+		m.fillPageToAddNew()
+		m.addHeader()
+	}
+
+	if breakType == pagebreak.Odd && (len(m.pages)+1)%2 == 0 {
+		m.fillPageToAddNew()
+		m.addHeader()
+	}
 }
 
+// addHeader adds the header of a new page, using the header and footer
+// of the variants of the page.
 func (m *Maroto) addHeader() {
+	m.setPageVariants(false)
+
 	for _, headerRow := range m.header {
-		m.currentHeight += headerRow.GetHeight()
+		m.currentHeight += m.getRowsHeight(headerRow)
 		m.rows = append(m.rows, headerRow)
 	}
 }
 
+func (m *Maroto) addRepeatedHeader(r core.Row) {
+	for _, headerRow := range r.GetRepeatedHeader() {
+		m.setRowsConfig(headerRow)
+		m.currentHeight += m.getRowsHeight(headerRow)
+		m.rows = append(m.rows, headerRow)
+	}
+}
+
+func (m *Maroto) addAnchor(r core.Row) {
+	anchor := r.GetAnchor()
+	if anchor == nil {
+		return
+	}
+
+	m.anchors = append(m.anchors, entity.Anchor{
+		Title: anchor.Title,
+		Level: anchor.Level,
+		Page:  len(m.pages) + 1,
+		Label: m.getPageSection(len(m.pages)).Label(),
+	})
+}
+
 func (m *Maroto) fillPageToAddNew() {
-	space := m.cell.Height - m.currentHeight - m.footerHeight
+	space := m.getRemainingHeight()
+
+	m.setPageRowsRange()
 
 	c := col.New(m.config.MaxGridSize)
 	spaceRow := row.New(space)
@@ -232,12 +690,14 @@ func (m *Maroto) fillPageToAddNew() {
 	m.rows = append(m.rows, m.footer...)
 
 	prop := props.Page{
-		Pattern: m.config.PageNumberPattern,
-		Place:   m.config.PageNumberPlace,
-		Family:  m.config.DefaultFont.Family,
-		Style:   m.config.DefaultFont.Style,
-		Size:    m.config.DefaultFont.Size,
-		Color:   m.config.DefaultFont.Color,
+		Pattern:     m.config.PageNumberPattern,
+		Place:       m.config.PageNumberPlace,
+		Family:      m.config.DefaultFont.Family,
+		Style:       m.config.DefaultFont.Style,
+		Size:        m.config.DefaultFont.Size,
+		Color:       m.config.DefaultFont.Color,
+		PageSize:    m.pageProp.PageSize,
+		Orientation: m.pageProp.Orientation,
 	}
 	p := page.New(prop)
 	p.Add(m.rows...)
@@ -245,36 +705,368 @@ func (m *Maroto) fillPageToAddNew() {
 	m.pages = append(m.pages, p)
 	m.rows = nil
 	m.currentHeight = 0
+	m.header = nil
+	m.headerHeight = 0
+	m.firstRow, m.lastRow = -1, -1
+}
+
+// fillLastPage closes the last page with the header and footer of the last page.
+// When the content does not fit with them, the content is kept in its page and
+// the last page is a new page with only the header and the footer.
+func (m *Maroto) fillLastPage() {
+	m.addKeptRows()
+
+	_, hasLastHeader := m.headers[pagevariant.Last]
+	_, hasLastFooter := m.footers[pagevariant.Last]
+	if !hasLastHeader && !hasLastFooter {
+		m.fillPageToAddNew()
+		return
+	}
+
+	header := m.getPageRows(m.headers, m.headerFunc, true)
+	footer := m.getPageRows(m.footers, m.footerFunc, true)
+	contentHeight := m.currentHeight - m.headerHeight
+
+	if !m.fitsPage(m.getRowsHeight(header...), contentHeight, m.getRowsHeight(footer...)) {
+		m.fillPageToAddNew()
+		m.addHeader()
+		contentHeight = 0
+	}
+
+	content := m.rows[len(m.header):]
+	m.setPageVariants(true)
+	m.rows = append(append([]core.Row{}, m.header...), content...)
+	m.currentHeight = m.headerHeight + contentHeight
+	m.fillPageToAddNew()
+}
+
+// setPageVariants uses the header and footer of the variants of the current page.
+func (m *Maroto) setPageVariants(last bool) {
+	m.header = m.getPageRows(m.headers, m.headerFunc, last)
+	m.footer = m.getPageRows(m.footers, m.footerFunc, last)
+	m.headerHeight = m.getRowsHeight(m.header...)
+	m.footerHeight = m.getRowsHeight(m.footer...)
+}
+
+// getPageRows returns the rows of the variant of the current page. The rows of the
+// default variant are built by the callback of the variants, when it is defined.
+func (m *Maroto) getPageRows(variants map[pagevariant.Type][]core.Row,
+	build func(ctx entity.PageContext) []core.Row, last bool,
+) []core.Row {
+	rows, variant := getVariantRows(variants, len(m.pages)+1, last)
+	if variant != pagevariant.Default || build == nil {
+		return rows
+	}
+
+	return []core.Row{m.newPageRows(build)}
+}
+
+// newPageRows builds the rows of the current page with the callback, to reserve their height.
+func (m *Maroto) newPageRows(build func(ctx entity.PageContext) []core.Row) *pageRows {
+	section := m.getPageSection(len(m.pages))
+	ctx := entity.PageContext{
+		Number:   len(m.pages) + 1,
+		Section:  section.Name,
+		Label:    section.Label(),
+		FirstRow: -1,
+		LastRow:  -1,
+	}
+	rows := build(ctx)
+	m.setRowsConfig(rows...)
+
+	return newPageRows(build, ctx, m.getRowsHeight(rows...))
+}
+
+// setPageRowsRange sets the rows placed in the current page in its built header and footer.
+func (m *Maroto) setPageRowsRange() {
+	for _, r := range append(append([]core.Row{}, m.header...), m.footer...) {
+		if rows, ok := r.(*pageRows); ok {
+			rows.ctx.FirstRow, rows.ctx.LastRow = m.firstRow, m.lastRow
+		}
+	}
+}
+
+// buildPageRows builds the headers and footers of the pages built by callbacks,
+// as the total of pages and the sections of the pages are known.
+func (m *Maroto) buildPageRows() {
+	for i, p := range m.pages {
+		for _, r := range p.GetRows() {
+			if rows, ok := r.(*pageRows); ok {
+				rows.setRows(i+1, len(m.pages), m.getPageSection(i))
+			}
+		}
+	}
+}
+
+// replaceHeader adds the header again in the current page,
+// which is only replaced before its content is added.
+func (m *Maroto) replaceHeader() {
+	if m.currentHeight != m.headerHeight {
+		return
+	}
+
+	m.rows = nil
+	m.currentHeight = 0
+	m.addHeader()
+}
+
+// getRegisteredFooter returns the footer of the variant, or the default
+// footer when the variant has no footer.
+func (m *Maroto) getRegisteredFooter(variant pagevariant.Type) []core.Row {
+	if footer, ok := m.footers[variant]; ok {
+		return footer
+	}
+
+	return m.footers[pagevariant.Default]
+}
+
+// setPageProp uses the size and orientation of prop in the pages being built,
+// measuring again the rows of the current page with the new width.
+func (m *Maroto) setPageProp(prop props.Page) {
+	m.pageProp = props.Page{PageSize: prop.PageSize, Orientation: prop.Orientation}
+
+	width, height := m.pageProp.GetDimensions(m.config.Dimensions.Width, m.config.Dimensions.Height)
+	m.cell = getRootCell(m.config, width, height)
+
+	m.headerHeight = m.getRowsHeight(m.header...)
+	m.footerHeight = m.getRowsHeight(m.footer...)
+	m.currentHeight = m.getRowsHeight(m.rows...)
+}
+
+// addTOCPages inserts the pages of the table of contents in the position where
+// it was added. The pages after the table of contents are built again after its
+// pages, as their numbers, the variants of their headers and footers and the
+// page breaks to odd pages depend on how many pages it occupies. It returns an
+// error when the quantity of pages does not settle after maxTOCRebuilds builds.
+func (m *Maroto) addTOCPages() error {
+	if m.tocState == nil {
+		return nil
+	}
+
+	state, operations := m.tocState, m.tocOperations
+	tocPages := m.paginate(m.toc.GetRows(m.anchors))
+
+	quantity := 0
+	for rebuilds := 0; quantity != len(tocPages); rebuilds++ {
+		if rebuilds == maxTOCRebuilds {
+			return errors.New("table of contents pages quantity does not settle")
+		}
+
+		quantity = len(tocPages)
+		m.rebuildAfterTOC(state, operations, quantity)
+		tocPages = m.paginate(m.toc.GetRows(m.anchors))
+	}
+
+	copy(m.pages[m.tocIndex:], tocPages)
+	m.tocState, m.tocOperations = nil, nil
+	return nil
+}
+
+// rebuildAfterTOC builds again the pages after the table of contents from the
+// state where it was added, with empty pages reserved for the table of contents.
+func (m *Maroto) rebuildAfterTOC(state *Maroto, operations []func(), tocPagesQuantity int) {
+	*m = *state.copyState()
+	m.tocState = nil
+
+	for i := 0; i < tocPagesQuantity; i++ {
+		m.pages = append(m.pages, page.New())
+	}
+
+	m.replaceHeader()
+	for _, operation := range operations {
+		operation()
+	}
+
+	m.fillLastPage()
+}
+
+// copyState returns a copy of the document, which is not changed
+// when the pages of the document are built.
+func (m *Maroto) copyState() *Maroto {
+	state := *m
+	state.pages = m.pages[:len(m.pages):len(m.pages)]
+	state.rows = append([]core.Row{}, m.rows...)
+	state.headers = maps.Clone(m.headers)
+	state.footers = maps.Clone(m.footers)
+	state.anchors = m.anchors[:len(m.anchors):len(m.anchors)]
+	state.kept = append([]core.Row{}, m.kept...)
+	state.sections = m.sections[:len(m.sections):len(m.sections)]
+
+	return &state
+}
+
+// runOperation runs an operation which builds the pages. The operations run
+// after the table of contents are kept, to build the pages after it again.
+func (m *Maroto) runOperation(operation func()) {
+	m.closed = false
+	if m.tocState != nil {
+		m.tocOperations = append(m.tocOperations, operation)
+	}
+
+	operation()
+}
+
+// paginate splits rows in new pages, placed after the pages of the table of
+// contents index, in the section of the table of contents, without changing
+// the pages being built.
+func (m *Maroto) paginate(rows []core.Row) []core.Page {
+	pages, currentRows, currentHeight := m.pages, m.rows, m.currentHeight
+	header, footer, headerHeight, footerHeight := m.header, m.footer, m.headerHeight, m.footerHeight
+	dataRows, firstRow, lastRow, sections := m.dataRows, m.firstRow, m.lastRow, m.sections
+	m.pages, m.rows, m.currentHeight = m.pages[:m.tocIndex:m.tocIndex], nil, 0
+	m.firstRow, m.lastRow, m.sections = -1, -1, m.sections[:m.tocSection]
+
+	m.addHeader()
+	m.addRows(rows...)
+	m.addKeptRows()
+	m.fillPageToAddNew()
+
+	paginated := m.pages[m.tocIndex:]
+	m.pages, m.rows, m.currentHeight = pages, currentRows, currentHeight
+	m.header, m.footer, m.headerHeight, m.footerHeight = header, footer, headerHeight, footerHeight
+	m.dataRows, m.firstRow, m.lastRow, m.sections = dataRows, firstRow, lastRow, sections
+
+	return paginated
 }
 
 func (m *Maroto) setConfig() {
 	for i, page := range m.pages {
 		page.SetConfig(m.config)
 		page.SetNumber(i+1, len(m.pages))
+		page.SetSection(m.getPageSection(i))
+	}
+}
+
+// getPageSection returns the section of the page with the index, which is the last
+// section started before or in the page, and the number of the page in it.
+func (m *Maroto) getPageSection(index int) entity.Section {
+	current, end := section{numbering: numbering.Decimal}, len(m.pages)
+	for _, s := range m.sections {
+		if s.start > index {
+			end = s.start
+			break
+		}
+
+		current = s
+	}
+
+	return entity.Section{
+		Name:      current.name,
+		Numbering: current.numbering,
+		Number:    index - current.start + 1,
+		Total:     end - current.start,
 	}
 }
 
-func (m *Maroto) generate() (core.Document, error) {
-	innerCtx := m.cell.Copy()
+// getPageLabels returns the numbering of the pages of each section, where the
+// pages before the first section are numbered as decimal numbers.
+func (m *Maroto) getPageLabels() []pagelabel.Label {
+	labels := []pagelabel.Label{{Numbering: numbering.Decimal}}
+	for _, s := range m.sections {
+		label := pagelabel.Label{Start: s.start, Numbering: s.numbering}
+		if last := len(labels) - 1; labels[last].Start == s.start {
+			labels[last] = label
+			continue
+		}
+
+		labels = append(labels, label)
+	}
+
+	return labels
+}
+
+func (m *Maroto) prepareGeneration() error {
+	m.provider.SetProtection(m.config.Protection)
+	m.provider.SetCompression(m.config.Compression)
+	m.provider.SetMetadata(m.config.Metadata)
+
+	if err := m.closeDocument(); err != nil {
+		return err
+	}
+
+	m.setConfig()
+	m.buildPageRows()
+	return nil
+}
+
+// closeDocument closes the last page and adds the pages of the table of contents,
+// only once until the document is changed again.
+func (m *Maroto) closeDocument() error {
+	if m.closed {
+		return nil
+	}
+
+	m.fillLastPage()
+	if err := m.addTOCPages(); err != nil {
+		return err
+	}
+
+	m.closed = true
+	return nil
+}
+
+// isConcurrent is true when pages can be generated by different providers,
+// which is only possible for PDFs without internal links.
+func (m *Maroto) isConcurrent() bool {
+	return m.config.WorkersQuantity > 0 && !m.hasDestinations && m.isPDF()
+}
+
+// hasPageLabels is true when the numbering of the sections is written in the document.
+func (m *Maroto) hasPageLabels() bool {
+	return len(m.sections) > 0 && m.isPDF()
+}
+
+func (m *Maroto) isPDF() bool {
+	return m.config.ProviderType != provider.HTML && m.config.ProviderType != provider.PNG
+}
+
+// generateBytes generates the document, with the page labels of the sections.
+func (m *Maroto) generateBytes(ctx context.Context) ([]byte, error) {
+	var documentBytes []byte
+	var err error
+
+	if m.isConcurrent() {
+		documentBytes, err = m.generateConcurrently(ctx)
+	} else {
+		documentBytes, err = m.generate(ctx)
+	}
+
+	if err != nil || !m.hasPageLabels() {
+		return documentBytes, err
+	}
+
+	return pagelabel.Write(documentBytes, m.getPageLabels(), m.config.Protection)
+}
+
+func (m *Maroto) render(ctx context.Context) error {
+	innerCtx := getRootCell(m.config, m.config.Dimensions.Width, m.config.Dimensions.Height)
 
 	for _, page := range m.pages {
+		if err := ctx.Err(); err != nil {
+			m.provider = getProvider(m.cache, m.config)
+			return err
+		}
+
 		page.Render(m.provider, innerCtx)
 	}
 
-	documentBytes, err := m.provider.GenerateBytes()
-	if err != nil {
+	return nil
+}
+
+func (m *Maroto) generate(ctx context.Context) ([]byte, error) {
+	if err := m.render(ctx); err != nil {
 		return nil, err
 	}
 
-	return core.NewPDF(documentBytes, nil), nil
+	return m.provider.GenerateBytes()
 }
 
-func (m *Maroto) generateConcurrently() (core.Document, error) {
+func (m *Maroto) generateConcurrently(ctx context.Context) ([]byte, error) {
 	chunks := len(m.pages) / m.config.WorkersQuantity
 	if chunks == 0 {
 		chunks = 1
 	}
-	pageGroups := make([][]core.Page, 0)
+	pageGroups := make([]pageGroup, 0)
 	for i := 0; i < len(m.pages); i += chunks {
 		end := i + chunks
 
@@ -282,12 +1074,16 @@ func (m *Maroto) generateConcurrently() (core.Document, error) {
 			end = len(m.pages)
 		}
 
-		pageGroups = append(pageGroups, m.pages[i:end])
+		pageGroups = append(pageGroups, pageGroup{ctx: ctx, pages: m.pages[i:end]})
 	}
 
 	processed := m.pool.Process(pageGroups)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	if processed.HasError {
-		return nil, errors.New("an error has occurred while trying to generate PDFs concurrently")
+		return nil, m.getProcessError(processed.GetErrors())
 	}
 
 	pdfs := make([][]byte, len(processed.Results))
@@ -296,34 +1092,134 @@ func (m *Maroto) generateConcurrently() (core.Document, error) {
 		pdfs[i] = bytes
 	}
 
-	mergedBytes, err := merge.Bytes(pdfs...)
-	if err != nil {
-		return nil, err
+	return merge.BytesWithOutlineLevels(m.getBookmarkLevels(), pdfs...)
+}
+
+// getBookmarkLevels returns the levels of the bookmarks of all pages in order,
+// so the bookmarks of a group of pages can be nested in a bookmark of the
+// previous groups when the PDFs of the groups are merged.
+func (m *Maroto) getBookmarkLevels() []int {
+	var levels []int
+
+	for _, page := range m.pages {
+		for _, r := range page.GetRows() {
+			rows := []core.Row{r}
+			if built, ok := r.(*pageRows); ok {
+				rows = built.rows
+			}
+
+			for _, r := range rows {
+				if bookmark := r.GetBookmark(); bookmark != nil {
+					levels = append(levels, bookmark.Level)
+				}
+			}
+		}
+	}
+
+	return levels
+}
+
+// getProcessError joins the failures of components of all workers, which
+// are returned in strict mode, or returns a generic error otherwise.
+func (m *Maroto) getProcessError(errs []error) error {
+	var componentErrors core.ComponentErrors
+
+	for _, err := range errs {
+		var errsOfWorker core.ComponentErrors
+		if !errors.As(err, &errsOfWorker) {
+			return errors.New("an error has occurred while trying to generate PDFs concurrently")
+		}
+
+		componentErrors = append(componentErrors, errsOfWorker...)
 	}
 
-	return core.NewPDF(mergedBytes, nil), nil
+	return componentErrors
 }
 
-func (m *Maroto) processPage(pages []core.Page) ([]byte, error) {
-	innerCtx := m.cell.Copy()
+func (m *Maroto) processPage(group pageGroup) ([]byte, error) {
+	innerCtx := getRootCell(m.config, m.config.Dimensions.Width, m.config.Dimensions.Height)
+
+	if err := group.ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	innerProvider := getProvider(cache.NewMutexDecorator(cache.New()), m.config)
-	for _, page := range pages {
+	for _, page := range group.pages {
+		if err := group.ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		page.Render(innerProvider, innerCtx)
 	}
 
 	return innerProvider.GenerateBytes()
 }
 
+// getRemainingHeight returns the height available for rows in the current page,
+// which is filled with empty space when a new page is added.
+func (m *Maroto) getRemainingHeight() float64 {
+	return m.cell.Height - m.currentHeight - m.footerHeight
+}
+
+// fitsPage returns true when content with the height fits in a page
+// with a header and a footer with the heights.
+func (m *Maroto) fitsPage(headerHeight, contentHeight, footerHeight float64) bool {
+	return headerHeight+contentHeight+footerHeight < m.cell.Height
+}
+
+// fitsCurrentPage returns true when rows with the height fit in the current page.
+func (m *Maroto) fitsCurrentPage(height float64) bool {
+	return m.fitsPage(m.currentHeight, height, m.footerHeight)
+}
+
+// fitsNewPage returns true when rows with the height fit in a new page
+// with the header and footer of the current page.
+func (m *Maroto) fitsNewPage(height float64) bool {
+	return m.fitsPage(m.headerHeight, height, m.footerHeight)
+}
+
 func (m *Maroto) getRowsHeight(rows ...core.Row) float64 {
 	var height float64
 	for _, r := range rows {
-		height += r.GetHeight()
+		height += core.GetRowHeight(r, m.provider, &m.cell)
 	}
 
 	return height
 }
 
+// setRowsConfig sets the config of the rows created without a height,
+// as the config is needed to measure their content.
+func (m *Maroto) setRowsConfig(rows ...core.Row) {
+	for _, r := range rows {
+		if measurable, ok := r.(core.Measurable); ok && measurable.IsAutoHeight() {
+			r.SetConfig(m.config)
+		}
+	}
+}
+
+// getVariantRows returns the rows and the variant of the page with the number,
+// which is the default variant when there is no specific one.
+func getVariantRows(variants map[pagevariant.Type][]core.Row, number int, last bool) ([]core.Row, pagevariant.Type) {
+	if rows, ok := variants[pagevariant.First]; ok && number == 1 {
+		return rows, pagevariant.First
+	}
+
+	if rows, ok := variants[pagevariant.Last]; ok && last {
+		return rows, pagevariant.Last
+	}
+
+	parity := pagevariant.Odd
+	if number%2 == 0 {
+		parity = pagevariant.Even
+	}
+
+	if rows, ok := variants[parity]; ok {
+		return rows, parity
+	}
+
+	return variants[pagevariant.Default], pagevariant.Default
+}
+
 func getConfig(configs ...*entity.Config) *entity.Config {
 	if len(configs) > 0 {
 		return configs[0]
@@ -332,7 +1228,24 @@ func getConfig(configs ...*entity.Config) *entity.Config {
 	return config.NewBuilder().Build()
 }
 
+// getRootCell returns the useful area of a page with the width and height.
+func getRootCell(cfg *entity.Config, width, height float64) entity.Cell {
+	return entity.NewRootCell(width, height, entity.Margins{
+		Left:   cfg.Margins.Left,
+		Top:    cfg.Margins.Top,
+		Right:  cfg.Margins.Right,
+		Bottom: cfg.Margins.Bottom,
+	})
+}
+
 func getProvider(cache cache.Cache, cfg *entity.Config) core.Provider {
-	deps := gofpdf.NewBuilder().Build(cfg, cache)
-	return gofpdf.New(deps)
+	switch cfg.ProviderType {
+	case provider.HTML:
+		return canvas.New(canvas.NewBuilder().Build(cfg, cache, html.New(cfg.CustomFonts)))
+	case provider.PNG:
+		return canvas.New(canvas.NewBuilder().Build(cfg, cache, png.New(cfg.CustomFonts)))
+	default:
+		deps := gofpdf.NewBuilder().Build(cfg, cache)
+		return gofpdf.New(deps)
+	}
 }
@@ -1,19 +1,44 @@
 package maroto_test
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/image"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/page"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/pagenumber"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/table"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/text"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/toc"
 	"github.com/miguelbernadi/maroto/v2/pkg/config"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/orientation"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagebreak"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagevariant"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/protection"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/provider"
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
 
 	"github.com/miguelbernadi/maroto/v2"
 
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNew(t *testing.T) {
@@ -122,98 +147,1110 @@ func TestMaroto_AddRows(t *testing.T) {
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_rows_3.json")
 	})
+	t.Run("add rows until add new page, with bottom margin, should use the bottom margin", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithBottomMargin(5).
+			Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 0; i < 20; i++ {
+			sut.AddRows(row.New(15).Add(col.New(12)))
+		}
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_rows_bottom_margin.json")
+	})
+}
+
+func TestMaroto_AddGroup(t *testing.T) {
+	t.Run("add group which does not fit in current page, should move group to new page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		for i := 0; i < 17; i++ {
+			sut.AddRows(row.New(15).Add(col.New(12)))
+		}
+
+		// Act
+		sut.AddGroup(text.NewRow(5, "heading"), text.NewRow(10, "content"))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_group.json")
+	})
+	t.Run("add row kept with next which does not fit in current page, should move rows to new page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		for i := 0; i < 17; i++ {
+			sut.AddRows(row.New(15).Add(col.New(12)))
+		}
+
+		// Act
+		sut.AddRows(text.NewRow(5, "heading").KeepWithNext(), text.NewRow(10, "content"))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_rows_kept_with_next.json")
+	})
+	t.Run("add row kept with next and next row in another call, should move rows to new page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		for i := 0; i < 17; i++ {
+			sut.AddRows(row.New(15).Add(col.New(12)))
+		}
+
+		// Act
+		sut.AddRows(text.NewRow(5, "heading").KeepWithNext())
+		sut.AddRows(text.NewRow(10, "content"))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_rows_kept_with_next.json")
+	})
+	t.Run("add row kept with next and next row by AddRow, should move rows to new page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		for i := 0; i < 17; i++ {
+			sut.AddRows(row.New(15).Add(col.New(12)))
+		}
+
+		// Act
+		sut.AddRows(text.NewRow(5, "heading").KeepWithNext())
+		sut.AddRow(10, col.New().Add(text.New("content")))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_rows_kept_with_next.json")
+	})
+	t.Run("add row kept with next as last row, should add it when document is generated", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		for i := 0; i < 17; i++ {
+			sut.AddRows(row.New(15).Add(col.New(12)))
+		}
+
+		// Act
+		sut.AddRows(text.NewRow(5, "heading").KeepWithNext())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_rows_kept_with_next_last.json")
+	})
+}
+
+func TestMaroto_AddSplitRows(t *testing.T) {
+	t.Run("add row higher than page, should split the text in pages", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		content := strings.Repeat("long text content ", 10)
+
+		// Act
+		sut.AddRows(row.New().Add(text.NewCol(12, content, props.Text{Size: 60, VerticalPadding: 30})))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_split_row.json")
+	})
+}
+
+func TestMaroto_AddAutoHeightRows(t *testing.T) {
+	t.Run("add auto height rows until add new page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		content := strings.Repeat("long text content ", 30)
+
+		// Act
+		for i := 0; i < 20; i++ {
+			sut.AddRows(row.New().Add(text.NewCol(12, content)))
+		}
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_auto_height_rows.json")
+	})
+}
+
+func TestMaroto_AddTable(t *testing.T) {
+	t.Run("add table until add new page, should repeat header", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		items := make([]int, 30)
+		columns := []table.Column[int]{
+			{Title: "Index", Size: 12, Format: func(_ int) string { return "item" }},
+		}
+		rows, _ := table.Build(items, columns, props.Table{RowHeight: 10})
+
+		// Act
+		sut.AddRows(rows...)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_table.json")
+	})
+}
+
+func TestMaroto_AddTOC(t *testing.T) {
+	t.Run("add toc before anchors, should reserve pages and shift page numbers", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddRows(text.NewRow(20, "cover"))
+		err := sut.AddTOC(toc.New("Contents"))
+		assert.Nil(t, err)
+		for i := 0; i < 3; i++ {
+			sut.AddRows(text.NewRow(10, fmt.Sprintf("Chapter %d", i)).WithAnchor(fmt.Sprintf("Chapter %d", i), 0))
+			sut.AddRows(text.NewRow(200, "content"))
+		}
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_toc.json")
+	})
+	t.Run("add toc in sections, should list the labels of the pages in their sections", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		long := strings.Repeat("long chapter title ", 12)
+
+		// Act
+		err := sut.StartSection("front", numbering.LowerRoman)
+		assert.Nil(t, err)
+		err = sut.AddTOC(toc.New("Contents"))
+		assert.Nil(t, err)
+		sut.AddPageBreak()
+		sut.AddRows(text.NewRow(10, "Preface").WithAnchor("Preface", 0))
+		err = sut.StartSection("main", numbering.Decimal)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(10, "Chapter 1").WithAnchor("Chapter 1", 0))
+		sut.AddRows(text.NewRow(10, "Chapter 2").WithAnchor(long, 0).WithPageBreakBefore())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_toc_sections.json")
+	})
+	t.Run("when toc is added again, should return error", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		err := sut.AddTOC(toc.New("Contents"))
+		assert.Nil(t, err)
+
+		// Act
+		err = sut.AddTOC(toc.New("Index"))
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when toc pages quantity does not settle, should return error", func(t *testing.T) {
+		// Arrange
+		contents := &mocks.TOC{}
+		contents.EXPECT().GetRows(mock.Anything).RunAndReturn(func(anchors []entity.Anchor) []core.Row {
+			// An anchor in an even page needs two pages, which moves it to an odd page.
+			if anchors[0].Page%2 == 0 {
+				return []core.Row{row.New(200), row.New(200)}
+			}
+
+			return []core.Row{row.New(10)}
+		})
+		sut := maroto.New()
+		err := sut.AddTOC(contents)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(10, "Chapter").WithAnchor("Chapter", 0))
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, doc)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestMaroto_AddPageBreak(t *testing.T) {
+	t.Run("when page has no rows, should keep the page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddPageBreak()
+		sut.AddRows(text.NewRow(10, "first"))
+
+		// Assert
+		assert.Len(t, sut.GetStructure().GetNexts(), 1)
+	})
+	t.Run("when page breaks are added, should start new pages and empty pages before odd pages", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		err := sut.RegisterHeader(text.NewRow(10, "header"))
+		assert.Nil(t, err)
+
+		// Act
+		sut.AddRows(text.NewRow(10, "cover"))
+		sut.AddPageBreak()
+		sut.AddRows(text.NewRow(10, "preface"))
+		sut.AddPageBreak(pagebreak.Odd)
+		sut.AddRows(text.NewRow(10, "chapter 1"))
+		sut.AddRows(text.NewRow(10, "chapter 2").WithPageBreakBefore())
+		sut.AddRows(text.NewRow(10, "chapter 3").WithPageBreakBefore(pagebreak.Odd))
+		sut.AddGroup(text.NewRow(10, "chapter 4").WithPageBreakBefore(pagebreak.Odd), text.NewRow(10, "content"))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_page_break.json")
+	})
+	t.Run("when page breaks to odd pages are after a table of contents, should count the pages of the table of contents", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddRows(text.NewRow(10, "cover"))
+		err := sut.AddTOC(toc.New("Contents"))
+		assert.Nil(t, err)
+		for i := 0; i < 2; i++ {
+			title := fmt.Sprintf("Chapter %d", i)
+			sut.AddRows(text.NewRow(10, title).WithAnchor(title, 0).WithPageBreakBefore(pagebreak.Odd))
+		}
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_page_break_after_toc.json")
+	})
+}
+
+func TestMaroto_StartSection(t *testing.T) {
+	t.Run("when numbering is not valid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.StartSection("appendix", numbering.Type("invalid"))
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when sections are started, should build headers with the section of each page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		err := sut.RegisterHeaderFunc(func(ctx entity.PageContext) []core.Row {
+			return []core.Row{text.NewRow(10, fmt.Sprintf("%s - %s", ctx.Section, ctx.Label))}
+		})
+		assert.Nil(t, err)
+
+		// Act
+		err = sut.StartSection("Preface", numbering.LowerRoman)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(200, "preface"), text.NewRow(200, "preface"))
+		err = sut.StartSection("Chapter", numbering.Decimal)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(100, "chapter"))
+		err = sut.StartSection("Appendix", numbering.UpperLetter)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(100, "appendix"))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_start_section.json")
+	})
+	t.Run("when sections are started, should write the page numbers of each section", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithProvider(provider.HTML).
+			WithPageNumber("{section} of {section_total} ({current} / {total})", props.Bottom).
+			Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		sut.AddRows(text.NewRow(100, "cover"))
+		err := sut.StartSection("Preface", numbering.LowerRoman)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(200, "preface"), text.NewRow(200, "preface"))
+		err = sut.StartSection("Appendix", numbering.Decimal)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(100, "appendix"))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		html := string(doc.GetBytes())
+		assert.Contains(t, html, ">1 of 1 (1 / 4)</text>")
+		assert.Contains(t, html, ">i of 2 (2 / 4)</text>")
+		assert.Contains(t, html, ">ii of 2 (3 / 4)</text>")
+		assert.Contains(t, html, ">1 of 1 (4 / 4)</text>")
+	})
+	t.Run("when sections are started, should write the page labels of the PDF", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddRows(text.NewRow(100, "cover"))
+		err := sut.StartSection("Preface", numbering.LowerRoman)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(200, "preface"), text.NewRow(200, "preface"))
+		err = sut.StartSection("Appendix", numbering.UpperLetter)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(100, "appendix"))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Equal(t, types.Array{
+			types.Integer(0),
+			types.Dict{"S": types.Name("D")},
+			types.Integer(1),
+			types.Dict{"S": types.Name("r")},
+			types.Integer(3),
+			types.Dict{"S": types.Name("A")},
+		}, getPageLabels(t, doc.GetBytes()))
+	})
+	t.Run("when sections are started, execute in parallel, should write the page labels of the PDF", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(2).
+			Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		err := sut.StartSection("Preface", numbering.UpperRoman)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(200, "preface"), text.NewRow(200, "preface"))
+		err = sut.StartSection("Chapter", numbering.Decimal)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(200, "chapter"), text.NewRow(200, "chapter"))
+
+		// Assert
+		var buf bytes.Buffer
+		err = sut.GenerateTo(&buf)
+		assert.Nil(t, err)
+		assert.Equal(t, types.Array{
+			types.Integer(0),
+			types.Dict{"S": types.Name("R")},
+			types.Integer(2),
+			types.Dict{"S": types.Name("D")},
+		}, getPageLabels(t, buf.Bytes()))
+	})
+	t.Run("when document is protected, should write the page labels of the PDF", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithProtection(protection.Print, "user", "owner").
+			Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		err := sut.StartSection("Preface", numbering.LowerRoman)
+		assert.Nil(t, err)
+		sut.AddRows(text.NewRow(20, "preface"))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "/Encrypt")
+	})
+	t.Run("when table of contents is in a section, should number its pages in the section", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.StartSection("Contents", numbering.LowerRoman)
+		assert.Nil(t, err)
+		err = sut.AddTOC(toc.New("Contents"))
+		assert.Nil(t, err)
+		err = sut.StartSection("Chapters", numbering.Decimal)
+		assert.Nil(t, err)
+		for i := 0; i < 80; i++ {
+			sut.AddRows(text.NewRow(200, fmt.Sprintf("Chapter %d", i)).WithAnchor(fmt.Sprintf("Chapter %d", i), 0))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Equal(t, types.Array{
+			types.Integer(0),
+			types.Dict{"S": types.Name("r")},
+			types.Integer(2),
+			types.Dict{"S": types.Name("D")},
+		}, getPageLabels(t, doc.GetBytes()))
+	})
+}
+
+func TestMaroto_AddPages(t *testing.T) {
+	t.Run("add one page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddPages(
+			page.New().Add(
+				row.New(20).Add(col.New(12)),
+			),
+		)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_pages_1.json")
+	})
+	t.Run("add two pages", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddPages(
+			page.New().Add(
+				row.New(20).Add(col.New(12)),
+			),
+			page.New().Add(
+				row.New(20).Add(col.New(12)),
+			),
+		)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_pages_2.json")
+	})
+	t.Run("add page greater than one page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		var rows []core.Row
+		for i := 0; i < 15; i++ {
+			rows = append(rows, row.New(20).Add(col.New(12)))
+		}
+
+		// Act
+		sut.AddPages(page.New().Add(rows...))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_pages_3.json")
+	})
+	t.Run("add landscape page greater than one page, should split rows with landscape height", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRows(row.New(20).Add(col.New(12)))
+		var rows []core.Row
+		for i := 0; i < 10; i++ {
+			rows = append(rows, row.New(20).Add(col.New(12)))
+		}
+
+		// Act
+		sut.AddPages(page.New(props.Page{Orientation: orientation.Horizontal}).Add(rows...))
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_pages_landscape.json")
+	})
+}
+
+func TestMaroto_RegisterHeaderVariant(t *testing.T) {
+	t.Run("when variant is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.RegisterHeaderVariant("invalid", text.NewRow(10, "header"))
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when header is greater than page, should return error", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.RegisterHeaderVariant(pagevariant.First, row.New(300))
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when there is first page header, should use it only in the first page with its own height", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.RegisterHeader(text.NewRow(10, "compact header"))
+		assert.Nil(t, err)
+		err = sut.RegisterHeaderVariant(pagevariant.First, text.NewRow(60, "letterhead"))
+		assert.Nil(t, err)
+		for i := 0; i < 15; i++ {
+			sut.AddRow(20, text.NewCol(12, fmt.Sprintf("row %d", i)))
+		}
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_register_header_first.json")
+	})
+	t.Run("when there is last page header, should replace the header of the last page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.RegisterHeader(text.NewRow(10, "header"))
+		assert.Nil(t, err)
+		err = sut.RegisterHeaderVariant(pagevariant.Last, text.NewRow(20, "last header"))
+		assert.Nil(t, err)
+		for i := 0; i < 15; i++ {
+			sut.AddRow(20, text.NewCol(12, fmt.Sprintf("row %d", i)))
+		}
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_register_header_last.json")
+	})
+	t.Run("when there is a table of contents, should count its pages to choose the variants", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.RegisterHeaderVariant(pagevariant.First, text.NewRow(30, "first header"))
+		assert.Nil(t, err)
+		err = sut.RegisterHeaderVariant(pagevariant.Odd, text.NewRow(20, "odd header"))
+		assert.Nil(t, err)
+		err = sut.RegisterHeaderVariant(pagevariant.Even, text.NewRow(10, "even header"))
+		assert.Nil(t, err)
+		err = sut.AddTOC(toc.New("Contents"))
+		assert.Nil(t, err)
+		for i := 0; i < 3; i++ {
+			title := fmt.Sprintf("Chapter %d", i)
+			sut.AddRows(text.NewRow(10, title).WithAnchor(title, 0).WithPageBreakBefore())
+		}
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_register_header_variant_toc.json")
+	})
+}
+
+func TestMaroto_RegisterFooterVariant(t *testing.T) {
+	t.Run("when variant is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.RegisterFooterVariant("invalid", text.NewRow(10, "footer"))
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when there are odd and even footers, should alternate them", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.RegisterFooterVariant(pagevariant.Odd, text.NewRow(10, "odd footer", props.Text{Align: align.Right}))
+		assert.Nil(t, err)
+		err = sut.RegisterFooterVariant(pagevariant.Even, text.NewRow(20, "even footer", props.Text{Align: align.Left}))
+		assert.Nil(t, err)
+		for i := 0; i < 30; i++ {
+			sut.AddRow(20, text.NewCol(12, fmt.Sprintf("row %d", i)))
+		}
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_register_footer_odd_even.json")
+	})
+	t.Run("when last page footer does not fit in the last page, should add a new page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.RegisterFooter(text.NewRow(10, "footer"))
+		assert.Nil(t, err)
+		err = sut.RegisterFooterVariant(pagevariant.Last, text.NewRow(50, "totals"))
+		assert.Nil(t, err)
+		for i := 0; i < 12; i++ {
+			sut.AddRow(20, text.NewCol(12, fmt.Sprintf("row %d", i)))
+		}
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_register_footer_last.json")
+	})
+}
+
+func TestMaroto_RegisterHeaderFunc(t *testing.T) {
+	t.Run("when header is greater than page, should return error", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.RegisterHeaderFunc(func(entity.PageContext) []core.Row {
+			return []core.Row{row.New(300)}
+		})
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when header and footer are built per page, should build them with the rows and total of the page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		err := sut.RegisterHeaderFunc(func(ctx entity.PageContext) []core.Row {
+			return []core.Row{text.NewRow(10, fmt.Sprintf("Accounts %d-%d", ctx.FirstRow, ctx.LastRow))}
+		})
+		assert.Nil(t, err)
+		err = sut.RegisterFooterFunc(func(ctx entity.PageContext) []core.Row {
+			if ctx.Number == 1 {
+				return []core.Row{text.NewRow(10, fmt.Sprintf("Page 1 of %d", ctx.Total))}
+			}
+
+			return []core.Row{text.NewRow(10, fmt.Sprintf("Continued from page %d", ctx.Number-1))}
+		})
+		assert.Nil(t, err)
+		for i := 0; i < 15; i++ {
+			sut.AddRow(20, text.NewCol(12, fmt.Sprintf("account %d", i)))
+		}
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_register_header_func.json")
+	})
+	t.Run("when footer is built per page after a first page footer, should keep the first page footer", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		footer := func(entity.PageContext) []core.Row {
+			return []core.Row{row.New(5)}
+		}
+
+		// Act
+		err := sut.RegisterFooterVariant(pagevariant.First, row.New(50))
+		assert.Nil(t, err)
+		err = sut.RegisterFooterFunc(footer)
+		assert.Nil(t, err)
+
+		// Assert
+		reverse := maroto.New()
+		err = reverse.RegisterFooterFunc(footer)
+		assert.Nil(t, err)
+		err = reverse.RegisterFooterVariant(pagevariant.First, row.New(50))
+		assert.Nil(t, err)
+		assert.InDelta(t, 216.9975, sut.Cursor().RemainingHeight, 0.0001)
+		assert.Equal(t, reverse.Cursor(), sut.Cursor())
+	})
+	t.Run("when header is built per page, execute in parallel, should generate the document", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(7).
+			Build()
+		sut := maroto.New(cfg)
+
+		// Act
+		err := sut.RegisterHeaderFunc(func(ctx entity.PageContext) []core.Row {
+			return []core.Row{text.NewRow(10, fmt.Sprintf("Page %d of %d", ctx.Number, ctx.Total))}
+		})
+		assert.Nil(t, err)
+		for i := 0; i < 30; i++ {
+			sut.AddRow(20, text.NewCol(12, fmt.Sprintf("row %d", i)))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.NotNil(t, doc)
+	})
+}
+
+func TestMaroto_Generate(t *testing.T) {
+	t.Run("add one row", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddRow(10, col.New(12))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.NotNil(t, doc)
+	})
+	t.Run("add two rows", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		sut.AddRow(10, col.New(12))
+		sut.AddRow(10, col.New(12))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.NotNil(t, doc)
+	})
+	t.Run("add rows until add new page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		for i := 0; i < 30; i++ {
+			sut.AddRow(10, col.New(12))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.NotNil(t, doc)
+	})
+	t.Run("add rows until add new page, execute in parallel", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(7).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 0; i < 30; i++ {
+			sut.AddRow(10, col.New(12))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.NotNil(t, doc)
+	})
+	t.Run("add rows with bookmarks, should keep bookmarks in the document", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+
+		// Act
+		for i := 0; i < 30; i++ {
+			sut.AddRows(text.NewRow(30, "chapter").WithBookmark(fmt.Sprintf("chapter %d", i), 0))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		bookmarks, err := api.Bookmarks(bytes.NewReader(doc.GetBytes()), api.LoadConfiguration())
+		assert.Nil(t, err)
+		assert.Len(t, bookmarks, 30)
+		assert.Equal(t, 4, bookmarks[29].PageFrom)
+	})
+	t.Run("add rows with bookmarks, execute in parallel, should keep bookmarks of all chunks", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(7).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 0; i < 30; i++ {
+			sut.AddRows(text.NewRow(30, "chapter").WithBookmark(fmt.Sprintf("chapter %d", i), 0))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		bookmarks, err := api.Bookmarks(bytes.NewReader(doc.GetBytes()), api.LoadConfiguration())
+		assert.Nil(t, err)
+		assert.Len(t, bookmarks, 30)
+		assert.Equal(t, 4, bookmarks[29].PageFrom)
+	})
+	t.Run("add rows with nested bookmarks, execute in parallel, should keep the nesting across chunks", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(7).
+			Build()
+
+		sut := maroto.New(cfg)
+		sut.AddRows(text.NewRow(200, "cover"))
+
+		// Act
+		for i := 0; i < 10; i++ {
+			sut.AddRows(text.NewRow(200, "chapter").WithBookmark(fmt.Sprintf("chapter %d", i), 0))
+			sut.AddRows(text.NewRow(200, "section").WithBookmark(fmt.Sprintf("section %d", i), 1))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		bookmarks, err := api.Bookmarks(bytes.NewReader(doc.GetBytes()), api.LoadConfiguration())
+		assert.Nil(t, err)
+		assert.Len(t, bookmarks, 10)
+		for i, bookmark := range bookmarks {
+			assert.Equal(t, fmt.Sprintf("chapter %d", i), bookmark.Title)
+			assert.Equal(t, 2+i*2, bookmark.PageFrom)
+			assert.Len(t, bookmark.Kids, 1)
+			assert.Equal(t, fmt.Sprintf("section %d", i), bookmark.Kids[0].Title)
+			assert.Equal(t, 3+i*2, bookmark.Kids[0].PageFrom)
+		}
+	})
+	t.Run("add rows with text watermark, execute in parallel, should draw it transparent in the pages", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(7).
+			WithTextWatermark("DRAFT", props.Watermark{Angle: 45}).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 0; i < 30; i++ {
+			sut.AddRow(10, col.New(12))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "/ca 0.3")
+	})
+	t.Run("add internal link to a row in a later page, should point to the page of the row", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(7).
+			Build()
+
+		sut := maroto.New(cfg)
+		destination := "details"
+
+		// Act
+		sut.AddRows(text.NewRow(10, "see details", props.Text{InternalLink: &destination}))
+		for i := 0; i < 12; i++ {
+			sut.AddRows(text.NewRow(50, "content"))
+		}
+		sut.AddRows(text.NewRow(10, "details").WithDestination(destination))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Equal(t, []int{3}, getLinkPages(t, doc.GetBytes(), 1))
+	})
 }
 
-func TestMaroto_AddPages(t *testing.T) {
-	t.Run("add one page", func(t *testing.T) {
+func TestMaroto_Generate_WhenHTMLProvider(t *testing.T) {
+	t.Run("add rows until add new page, should draw one svg per page", func(t *testing.T) {
 		// Arrange
-		sut := maroto.New()
+		cfg := config.NewBuilder().
+			WithProvider(provider.HTML).
+			WithPageNumber("{current} / {total}", props.RightBottom).
+			Build()
+
+		sut := maroto.New(cfg)
 
 		// Act
-		sut.AddPages(
-			page.New().Add(
-				row.New(20).Add(col.New(12)),
-			),
-		)
+		for i := 0; i < 30; i++ {
+			sut.AddRow(10, text.NewCol(12, fmt.Sprintf("row %d", i)))
+		}
 
 		// Assert
-		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_pages_1.json")
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		html := string(doc.GetBytes())
+		assert.True(t, strings.HasPrefix(html, "<!DOCTYPE html>"))
+		assert.Equal(t, 2, strings.Count(html, "<svg"))
+		assert.Contains(t, html, ">row 29</text>")
+		assert.Contains(t, html, ">2 / 2</text>")
 	})
-	t.Run("add two pages", func(t *testing.T) {
+	t.Run("add page number in the footer, should draw the number of each page", func(t *testing.T) {
 		// Arrange
-		sut := maroto.New()
+		cfg := config.NewBuilder().
+			WithProvider(provider.HTML).
+			Build()
+
+		sut := maroto.New(cfg)
+		err := sut.RegisterFooter(row.New(10).Add(
+			text.NewCol(8, "Company address"),
+			pagenumber.NewCol(4, "Page {current} of {total}"),
+		))
+		assert.Nil(t, err)
 
 		// Act
-		sut.AddPages(
-			page.New().Add(
-				row.New(20).Add(col.New(12)),
-			),
-			page.New().Add(
-				row.New(20).Add(col.New(12)),
-			),
-		)
+		for i := 0; i < 30; i++ {
+			sut.AddRow(10, col.New(12))
+		}
 
 		// Assert
-		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_pages_2.json")
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), ">Page 1 of 2</text>")
+		assert.Contains(t, string(doc.GetBytes()), ">Page 2 of 2</text>")
 	})
-	t.Run("add page greater than one page", func(t *testing.T) {
+	t.Run("add rows until add new page, with worker pool, should not generate concurrently", func(t *testing.T) {
 		// Arrange
-		sut := maroto.New()
-		var rows []core.Row
-		for i := 0; i < 15; i++ {
-			rows = append(rows, row.New(20).Add(col.New(12)))
+		cfg := config.NewBuilder().
+			WithProvider(provider.HTML).
+			WithWorkerPoolSize(7).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 0; i < 30; i++ {
+			sut.AddRow(10, col.New(12))
 		}
 
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, strings.Count(string(doc.GetBytes()), "<svg"))
+	})
+}
+
+func TestMaroto_Generate_WhenPNGProvider(t *testing.T) {
+	// Arrange
+	cfg := config.NewBuilder().
+		WithProvider(provider.PNG).
+		WithWorkerPoolSize(7).
+		Build()
+
+	sut := maroto.New(cfg)
+
+	// Act
+	for i := 0; i < 30; i++ {
+		sut.AddRow(10, text.NewCol(12, fmt.Sprintf("row %d", i)))
+	}
+
+	// Assert
+	doc, err := sut.Generate()
+	assert.Nil(t, err)
+	archive, err := zip.NewReader(bytes.NewReader(doc.GetBytes()), int64(len(doc.GetBytes())))
+	assert.Nil(t, err)
+	assert.Len(t, archive.File, 2)
+	assert.Equal(t, "page-1.png", archive.File[0].Name)
+	assert.Equal(t, "page-2.png", archive.File[1].Name)
+	assert.ErrorIs(t, doc.Merge(doc.GetBytes()), core.ErrMergeNotSupported)
+}
+
+func TestMaroto_Generate_WhenStrictMode(t *testing.T) {
+	t.Run("when a component fails, should return error with the path of the component", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithStrictMode(true).
+			Build()
+
+		sut := maroto.New(cfg)
+
 		// Act
-		sut.AddPages(page.New().Add(rows...))
+		sut.AddRow(260, col.New(12))
+		sut.AddRow(10, col.New(6), image.NewFromBytesCol(6, []byte{1, 2, 3}, extension.Png))
 
 		// Assert
-		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_pages_3.json")
+		doc, err := sut.Generate()
+		assert.Nil(t, doc)
+		var componentErrs core.ComponentErrors
+		assert.True(t, errors.As(err, &componentErrs))
+		assert.Len(t, componentErrs, 1)
+		assert.Equal(t, entity.Path{
+			Page: 2, Row: 0, Col: 1,
+		}, componentErrs[0].Path)
+		assert.Equal(t, "could not add image to document", componentErrs[0].Message)
+	})
+	t.Run("when an image of an auto-height row fails, should return error of the image", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithStrictMode(true).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		sut.AddRows(row.New().Add(image.NewFromBytesCol(12, []byte{1, 2, 3}, extension.Png)))
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, doc)
+		var componentErrs core.ComponentErrors
+		assert.True(t, errors.As(err, &componentErrs))
+		assert.Len(t, componentErrs, 1)
+		assert.Equal(t, "could not add image to document", componentErrs[0].Message)
+	})
+	t.Run("when components fail, execute in parallel, should return errors of all pages", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithStrictMode(true).
+			WithWorkerPoolSize(7).
+			Build()
+
+		sut := maroto.New(cfg)
+
+		// Act
+		for i := 0; i < 10; i++ {
+			sut.AddRow(150, image.NewFromBytesCol(12, []byte{1, 2, 3}, extension.Png))
+		}
+
+		// Assert
+		doc, err := sut.Generate()
+		assert.Nil(t, doc)
+		var componentErrs core.ComponentErrors
+		assert.True(t, errors.As(err, &componentErrs))
+		assert.Len(t, componentErrs, 10)
+		assert.Equal(t, 10, componentErrs[9].Path.Page)
 	})
 }
 
-func TestMaroto_Generate(t *testing.T) {
-	t.Run("add one row", func(t *testing.T) {
+func TestMaroto_GenerateContext(t *testing.T) {
+	t.Run("when context is not done, should generate the document", func(t *testing.T) {
 		// Arrange
 		sut := maroto.New()
+		sut.AddRow(10, col.New(12))
 
 		// Act
-		sut.AddRow(10, col.New(12))
+		doc, err := sut.GenerateContext(context.Background())
 
 		// Assert
-		doc, err := sut.Generate()
 		assert.Nil(t, err)
 		assert.NotNil(t, doc)
 	})
-	t.Run("add two rows", func(t *testing.T) {
+	t.Run("when context is canceled, should return context error", func(t *testing.T) {
 		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
 		sut := maroto.New()
+		for i := 0; i < 30; i++ {
+			sut.AddRow(30, col.New(12))
+		}
 
 		// Act
-		sut.AddRow(10, col.New(12))
-		sut.AddRow(10, col.New(12))
+		doc, err := sut.GenerateContext(ctx)
 
 		// Assert
+		assert.Nil(t, doc)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+	t.Run("when context is canceled, should generate the same pages again", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		sut := maroto.New()
+		err := sut.AddTOC(toc.New("Contents"))
+		assert.Nil(t, err)
+		for i := 0; i < 15; i++ {
+			sut.AddRows(row.New(30).WithAnchor(fmt.Sprintf("row %d", i), 0))
+		}
+
+		// Act
+		_, err = sut.GenerateContext(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
 		doc, err := sut.Generate()
+
+		// Assert
 		assert.Nil(t, err)
-		assert.NotNil(t, doc)
+		pageCount, err := api.PageCount(bytes.NewReader(doc.GetBytes()), api.LoadConfiguration())
+		assert.Nil(t, err)
+		assert.Equal(t, 3, pageCount)
 	})
-	t.Run("add rows until add new page", func(t *testing.T) {
+	t.Run("when context deadline is exceeded, execute in parallel, should return context error", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancel()
+
+		cfg := config.NewBuilder().
+			WithWorkerPoolSize(7).
+			Build()
+
+		sut := maroto.New(cfg)
+		for i := 0; i < 30; i++ {
+			sut.AddRow(30, col.New(12))
+		}
+
+		// Act
+		doc, err := sut.GenerateContext(ctx)
+
+		// Assert
+		assert.Nil(t, doc)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestMaroto_GenerateTo(t *testing.T) {
+	t.Run("add rows until add new page, should write the document", func(t *testing.T) {
 		// Arrange
+		var buf bytes.Buffer
 		sut := maroto.New()
 
 		// Act
 		for i := 0; i < 30; i++ {
-			sut.AddRow(10, col.New(12))
+			sut.AddRow(30, col.New(12))
 		}
 
 		// Assert
-		doc, err := sut.Generate()
+		err := sut.GenerateTo(&buf)
 		assert.Nil(t, err)
-		assert.NotNil(t, doc)
+		assert.True(t, strings.HasPrefix(buf.String(), "%PDF"))
+		pageCount, err := api.PageCount(bytes.NewReader(buf.Bytes()), api.LoadConfiguration())
+		assert.Nil(t, err)
+		assert.Equal(t, 4, pageCount)
 	})
-	t.Run("add rows until add new page, execute in parallel", func(t *testing.T) {
+	t.Run("add rows until add new page, execute in parallel, should write the document", func(t *testing.T) {
 		// Arrange
+		var buf bytes.Buffer
 		cfg := config.NewBuilder().
 			WithWorkerPoolSize(7).
 			Build()
@@ -222,13 +1259,38 @@ func TestMaroto_Generate(t *testing.T) {
 
 		// Act
 		for i := 0; i < 30; i++ {
-			sut.AddRow(10, col.New(12))
+			sut.AddRow(30, col.New(12))
 		}
 
 		// Assert
-		doc, err := sut.Generate()
+		err := sut.GenerateTo(&buf)
 		assert.Nil(t, err)
-		assert.NotNil(t, doc)
+		pageCount, err := api.PageCount(bytes.NewReader(buf.Bytes()), api.LoadConfiguration())
+		assert.Nil(t, err)
+		assert.Equal(t, 4, pageCount)
+	})
+	t.Run("add rows until add new page, with bottom margin smaller than default, should not break pages inside the rows", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		cfg := config.NewBuilder().
+			WithBottomMargin(0).
+			Build()
+
+		sut := maroto.New(cfg)
+		err := sut.RegisterFooter(text.NewRow(10, "footer"))
+		assert.Nil(t, err)
+
+		// Act
+		for i := 0; i < 100; i++ {
+			sut.AddRow(27.7, text.NewCol(12, fmt.Sprintf("row %d", i)))
+		}
+
+		// Assert
+		err = sut.GenerateTo(&buf)
+		assert.Nil(t, err)
+		pageCount, err := api.PageCount(bytes.NewReader(buf.Bytes()), api.LoadConfiguration())
+		assert.Nil(t, err)
+		assert.Equal(t, 10, pageCount)
 	})
 }
 
@@ -246,6 +1308,15 @@ func TestMaroto_FitlnCurrentPage(t *testing.T) {
 		sut.AddPages(page.New().Add(rows...))
 		assert.False(t, sut.FitlnCurrentPage(40))
 	})
+	t.Run("when page has header, should count the header once", func(t *testing.T) {
+		sut := maroto.New()
+		err := sut.RegisterHeader(row.New(100))
+		assert.Nil(t, err)
+
+		sut.AddRows(row.New(100))
+		assert.True(t, sut.FitlnCurrentPage(60))
+		assert.False(t, sut.FitlnCurrentPage(70))
+	})
 	t.Run("when component is larger should the available size, then true", func(t *testing.T) {
 		sut := maroto.New(config.NewBuilder().
 			WithDimensions(210.0, 297.0).
@@ -260,3 +1331,132 @@ func TestMaroto_FitlnCurrentPage(t *testing.T) {
 		assert.True(t, sut.FitlnCurrentPage(40))
 	})
 }
+
+func TestMaroto_Cursor(t *testing.T) {
+	t.Run("when document is empty, should return the first page with its useful area", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New(config.NewBuilder().
+			WithDimensions(210.0, 297.0).
+			WithMargins(10, 15, 10).
+			WithBottomMargin(20).
+			Build())
+
+		// Act
+		cursor := sut.Cursor()
+
+		// Assert
+		assert.Equal(t, entity.Cursor{
+			Page:            0,
+			Pages:           1,
+			RemainingHeight: 262,
+			UsefulArea:      entity.Dimensions{Width: 190, Height: 262},
+		}, cursor)
+	})
+	t.Run("when rows are added, should return the height available in the current page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New(config.NewBuilder().
+			WithDimensions(210.0, 297.0).
+			WithMargins(10, 15, 10).
+			WithBottomMargin(20).
+			Build())
+		err := sut.RegisterHeader(row.New(20))
+		assert.Nil(t, err)
+		err = sut.RegisterFooter(row.New(10))
+		assert.Nil(t, err)
+
+		// Act
+		sut.AddRows(row.New(100), row.New(100), row.New(50))
+		cursor := sut.Cursor()
+
+		// Assert
+		assert.Equal(t, entity.Cursor{
+			Page:            1,
+			Pages:           2,
+			RemainingHeight: 182,
+			UsefulArea:      entity.Dimensions{Width: 190, Height: 232},
+		}, cursor)
+	})
+	t.Run("when a row has the remaining height, should add it in a new page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRows(row.New(100))
+
+		// Act
+		remaining := sut.Cursor().RemainingHeight
+		sut.AddRows(row.New(remaining))
+
+		// Assert
+		assert.False(t, sut.FitlnCurrentPage(remaining))
+		assert.Equal(t, 1, sut.Cursor().Page)
+	})
+	t.Run("when rows are kept with the next row, should place them as they are added", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRows(row.New(200))
+
+		// Act
+		sut.AddRows(row.New(100).KeepWithNext())
+		cursor := sut.Cursor()
+
+		// Assert
+		assert.Equal(t, 1, cursor.Page)
+		assert.InDelta(t, 166.9975, cursor.RemainingHeight, 0.0001)
+	})
+	t.Run("when document has toc, should count the pages of the toc", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRows(text.NewRow(20, "cover"))
+		err := sut.AddTOC(toc.New("Contents"))
+		assert.Nil(t, err)
+
+		// Act
+		sut.AddRows(text.NewRow(10, "Chapter").WithAnchor("Chapter", 0))
+		cursor := sut.Cursor()
+
+		// Assert
+		assert.Equal(t, 2, cursor.Page)
+		assert.Equal(t, 3, cursor.Pages)
+	})
+}
+
+func getPageLabels(t *testing.T, pdf []byte) types.Array {
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), api.LoadConfiguration())
+	assert.Nil(t, err)
+
+	rootDict, err := ctx.Catalog()
+	assert.Nil(t, err)
+
+	labels, err := ctx.DereferenceDict(rootDict["PageLabels"])
+	assert.Nil(t, err)
+
+	return labels.ArrayEntry("Nums")
+}
+
+func getLinkPages(t *testing.T, pdf []byte, page int) []int {
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), api.LoadConfiguration())
+	assert.Nil(t, err)
+	assert.Nil(t, ctx.EnsurePageCount())
+
+	pageDict, _, _, err := ctx.PageDict(page, false)
+	assert.Nil(t, err)
+
+	annots, err := ctx.DereferenceArray(pageDict["Annots"])
+	assert.Nil(t, err)
+
+	var pages []int
+	for _, annot := range annots {
+		annotDict, err := ctx.DereferenceDict(annot)
+		assert.Nil(t, err)
+
+		dest, err := ctx.DereferenceArray(annotDict["Dest"])
+		assert.Nil(t, err)
+
+		pageRef := dest[0].(types.IndirectRef)
+		pageNumber, err := ctx.PageNumber(pageRef.ObjectNumber.Value())
+		assert.Nil(t, err)
+
+		pages = append(pages, pageNumber)
+	}
+
+	return pages
+}
@@ -1,9 +1,17 @@
 package maroto
 
 import (
+	"context"
+	"io"
+
 	"github.com/johnfercher/go-tree/node"
+
 	"github.com/miguelbernadi/maroto/v2/internal/time"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagebreak"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagevariant"
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/miguelbernadi/maroto/v2/pkg/metrics"
 )
 
@@ -30,13 +38,29 @@ func (m *MetricsDecorator) FitlnCurrentPage(heightNewLine float64) bool {
 	return m.inner.FitlnCurrentPage(heightNewLine)
 }
 
+// Cursor decorates the Cursor method of maroto instance.
+func (m *MetricsDecorator) Cursor() entity.Cursor {
+	return m.inner.Cursor()
+}
+
 // Generate decorates the Generate method of maroto instance.
 func (m *MetricsDecorator) Generate() (core.Document, error) {
+	return m.generate(m.inner.Generate)
+}
+
+// GenerateContext decorates the GenerateContext method of maroto instance.
+func (m *MetricsDecorator) GenerateContext(ctx context.Context) (core.Document, error) {
+	return m.generate(func() (core.Document, error) {
+		return m.inner.GenerateContext(ctx)
+	})
+}
+
+func (m *MetricsDecorator) generate(generate func() (core.Document, error)) (core.Document, error) {
 	var document core.Document
 	var err error
 
 	timeSpent := time.GetTimeSpent(func() {
-		document, err = m.inner.Generate()
+		document, err = generate()
 	})
 	m.generateTime = timeSpent
 
@@ -48,9 +72,26 @@ func (m *MetricsDecorator) Generate() (core.Document, error) {
 
 	report := m.buildMetrics(len(bytes)).Normalize()
 
+	if _, ok := document.(*core.File); ok {
+		return core.NewFile(bytes, report), nil
+	}
+
 	return core.NewPDF(bytes, report), nil
 }
 
+// GenerateTo decorates the GenerateTo method of maroto instance. As the
+// document is not kept, only the time spent is measured.
+func (m *MetricsDecorator) GenerateTo(w io.Writer) error {
+	var err error
+
+	timeSpent := time.GetTimeSpent(func() {
+		err = m.inner.GenerateTo(w)
+	})
+	m.generateTime = timeSpent
+
+	return err
+}
+
 // AddPages decorates the AddPages method of maroto instance.
 func (m *MetricsDecorator) AddPages(pages ...core.Page) {
 	timeSpent := time.GetTimeSpent(func() {
@@ -60,6 +101,21 @@ func (m *MetricsDecorator) AddPages(pages ...core.Page) {
 	m.addPageTime = append(m.addPageTime, timeSpent)
 }
 
+// AddTOC decorates the AddTOC method of maroto instance.
+func (m *MetricsDecorator) AddTOC(toc core.TOC) error {
+	return m.inner.AddTOC(toc)
+}
+
+// AddPageBreak decorates the AddPageBreak method of maroto instance.
+func (m *MetricsDecorator) AddPageBreak(breakType ...pagebreak.Type) {
+	m.inner.AddPageBreak(breakType...)
+}
+
+// StartSection decorates the StartSection method of maroto instance.
+func (m *MetricsDecorator) StartSection(name string, numberingStyle numbering.Type) error {
+	return m.inner.StartSection(name, numberingStyle)
+}
+
 // AddRows decorates the AddRows method of maroto instance.
 func (m *MetricsDecorator) AddRows(rows ...core.Row) {
 	timeSpent := time.GetTimeSpent(func() {
@@ -69,6 +125,16 @@ func (m *MetricsDecorator) AddRows(rows ...core.Row) {
 	m.addRowsTime = append(m.addRowsTime, timeSpent)
 }
 
+// AddGroup decorates the AddGroup method of maroto instance,
+// which time is measured with the time of AddRows.
+func (m *MetricsDecorator) AddGroup(rows ...core.Row) {
+	timeSpent := time.GetTimeSpent(func() {
+		m.inner.AddGroup(rows...)
+	})
+
+	m.addRowsTime = append(m.addRowsTime, timeSpent)
+}
+
 // AddRow decorates the AddRow method of maroto instance.
 func (m *MetricsDecorator) AddRow(rowHeight float64, cols ...core.Col) core.Row {
 	var r core.Row
@@ -100,6 +166,50 @@ func (m *MetricsDecorator) RegisterFooter(rows ...core.Row) error {
 	return err
 }
 
+// RegisterHeaderVariant decorates the RegisterHeaderVariant method of maroto instance,
+// which time is measured with the time of RegisterHeader.
+func (m *MetricsDecorator) RegisterHeaderVariant(variant pagevariant.Type, rows ...core.Row) error {
+	var err error
+	timeSpent := time.GetTimeSpent(func() {
+		err = m.inner.RegisterHeaderVariant(variant, rows...)
+	})
+	m.headerTime = timeSpent
+	return err
+}
+
+// RegisterFooterVariant decorates the RegisterFooterVariant method of maroto instance,
+// which time is measured with the time of RegisterFooter.
+func (m *MetricsDecorator) RegisterFooterVariant(variant pagevariant.Type, rows ...core.Row) error {
+	var err error
+	timeSpent := time.GetTimeSpent(func() {
+		err = m.inner.RegisterFooterVariant(variant, rows...)
+	})
+	m.footerTime = timeSpent
+	return err
+}
+
+// RegisterHeaderFunc decorates the RegisterHeaderFunc method of maroto instance,
+// which time is measured with the time of RegisterHeader.
+func (m *MetricsDecorator) RegisterHeaderFunc(build func(ctx entity.PageContext) []core.Row) error {
+	var err error
+	timeSpent := time.GetTimeSpent(func() {
+		err = m.inner.RegisterHeaderFunc(build)
+	})
+	m.headerTime = timeSpent
+	return err
+}
+
+// RegisterFooterFunc decorates the RegisterFooterFunc method of maroto instance,
+// which time is measured with the time of RegisterFooter.
+func (m *MetricsDecorator) RegisterFooterFunc(build func(ctx entity.PageContext) []core.Row) error {
+	var err error
+	timeSpent := time.GetTimeSpent(func() {
+		err = m.inner.RegisterFooterFunc(build)
+	})
+	m.footerTime = timeSpent
+	return err
+}
+
 // GetStructure decorates the GetStructure method of maroto instance.
 func (m *MetricsDecorator) GetStructure() *node.Node[core.Structure] {
 	var tree *node.Node[core.Structure]
@@ -1,17 +1,26 @@
 package maroto
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/johnfercher/go-tree/node"
+
 	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagebreak"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagevariant"
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/page"
-	"github.com/stretchr/testify/assert"
 )
 
 func TestNewMetricsDecorator(t *testing.T) {
@@ -113,6 +122,33 @@ func TestMetricsDecorator_AddRows(t *testing.T) {
 	inner.AssertNumberOfCalls(t, "AddRows", 2)
 }
 
+func TestMetricsDecorator_AddGroup(t *testing.T) {
+	// Arrange
+	row := row.New(10).Add(col.New(12))
+
+	docToReturn := &mocks.Document{}
+	docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+	inner := &mocks.Maroto{}
+	inner.EXPECT().AddGroup(row, row)
+	inner.EXPECT().Generate().Return(docToReturn, nil)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	sut.AddGroup(row, row)
+
+	// Assert
+	doc, err := sut.Generate()
+	assert.Nil(t, err)
+	assert.NotNil(t, doc)
+
+	report := doc.GetReport()
+	assert.NotNil(t, report)
+	assert.Equal(t, "add_rows", report.TimeMetrics[1].Key)
+	assert.Equal(t, 1, len(report.TimeMetrics[1].Times))
+	inner.AssertNumberOfCalls(t, "AddGroup", 1)
+}
+
 func TestMetricsDecorator_GetStructure(t *testing.T) {
 	// Arrange
 	row := row.New(10).Add(col.New(12))
@@ -156,3 +192,167 @@ func TestMetricsDecorator_FitlnCurrentPage(t *testing.T) {
 	assert.True(t, sut.FitlnCurrentPage(10))
 	assert.False(t, sut.FitlnCurrentPage(20))
 }
+
+func TestMetricsDecorator_Cursor(t *testing.T) {
+	// Arrange
+	cursor := entity.Cursor{Page: 1, Pages: 2, RemainingHeight: 100}
+
+	inner := &mocks.Maroto{}
+	inner.EXPECT().Cursor().Return(cursor)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	current := sut.Cursor()
+
+	// Assert
+	assert.Equal(t, cursor, current)
+	inner.AssertNumberOfCalls(t, "Cursor", 1)
+}
+
+func TestMetricsDecorator_RegisterHeaderVariant(t *testing.T) {
+	// Arrange
+	r := row.New(10).Add(col.New(12))
+
+	inner := &mocks.Maroto{}
+	inner.EXPECT().RegisterHeaderVariant(pagevariant.First, r).Return(nil)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	err := sut.RegisterHeaderVariant(pagevariant.First, r)
+
+	// Assert
+	assert.Nil(t, err)
+	inner.AssertNumberOfCalls(t, "RegisterHeaderVariant", 1)
+}
+
+func TestMetricsDecorator_RegisterFooterVariant(t *testing.T) {
+	// Arrange
+	r := row.New(10).Add(col.New(12))
+
+	inner := &mocks.Maroto{}
+	inner.EXPECT().RegisterFooterVariant(pagevariant.Last, r).Return(nil)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	err := sut.RegisterFooterVariant(pagevariant.Last, r)
+
+	// Assert
+	assert.Nil(t, err)
+	inner.AssertNumberOfCalls(t, "RegisterFooterVariant", 1)
+}
+
+func TestMetricsDecorator_RegisterHeaderFunc(t *testing.T) {
+	// Arrange
+	inner := &mocks.Maroto{}
+	inner.EXPECT().RegisterHeaderFunc(mock.Anything).Return(nil)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	err := sut.RegisterHeaderFunc(func(entity.PageContext) []core.Row { return nil })
+
+	// Assert
+	assert.Nil(t, err)
+	inner.AssertNumberOfCalls(t, "RegisterHeaderFunc", 1)
+}
+
+func TestMetricsDecorator_RegisterFooterFunc(t *testing.T) {
+	// Arrange
+	inner := &mocks.Maroto{}
+	inner.EXPECT().RegisterFooterFunc(mock.Anything).Return(nil)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	err := sut.RegisterFooterFunc(func(entity.PageContext) []core.Row { return nil })
+
+	// Assert
+	assert.Nil(t, err)
+	inner.AssertNumberOfCalls(t, "RegisterFooterFunc", 1)
+}
+
+func TestMetricsDecorator_AddTOC(t *testing.T) {
+	// Arrange
+	toc := &mocks.TOC{}
+
+	inner := &mocks.Maroto{}
+	inner.EXPECT().AddTOC(toc).Return(nil)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	err := sut.AddTOC(toc)
+
+	// Assert
+	assert.Nil(t, err)
+	inner.AssertNumberOfCalls(t, "AddTOC", 1)
+}
+
+func TestMetricsDecorator_AddPageBreak(t *testing.T) {
+	// Arrange
+	inner := &mocks.Maroto{}
+	inner.EXPECT().AddPageBreak(pagebreak.Odd)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	sut.AddPageBreak(pagebreak.Odd)
+
+	// Assert
+	inner.AssertNumberOfCalls(t, "AddPageBreak", 1)
+}
+
+func TestMetricsDecorator_StartSection(t *testing.T) {
+	// Arrange
+	inner := &mocks.Maroto{}
+	inner.EXPECT().StartSection("appendix", numbering.UpperLetter).Return(nil)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	err := sut.StartSection("appendix", numbering.UpperLetter)
+
+	// Assert
+	assert.Nil(t, err)
+	inner.AssertNumberOfCalls(t, "StartSection", 1)
+}
+
+func TestMetricsDecorator_GenerateTo(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+
+	inner := &mocks.Maroto{}
+	inner.EXPECT().GenerateTo(&buf).Return(nil)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	err := sut.GenerateTo(&buf)
+
+	// Assert
+	assert.Nil(t, err)
+	inner.AssertNumberOfCalls(t, "GenerateTo", 1)
+}
+
+func TestMetricsDecorator_GenerateContext(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	docToReturn := core.NewPDF([]byte{1, 2, 3}, nil)
+
+	inner := &mocks.Maroto{}
+	inner.EXPECT().GenerateContext(ctx).Return(docToReturn, nil)
+
+	sut := NewMetricsDecorator(inner)
+
+	// Act
+	doc, err := sut.GenerateContext(ctx)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, doc.GetBytes())
+	assert.Equal(t, "generate", doc.GetReport().TimeMetrics[0].Key)
+	inner.AssertNumberOfCalls(t, "GenerateContext", 1)
+}
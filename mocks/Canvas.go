@@ -0,0 +1,419 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+
+	entity "github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	props "github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+// Canvas is an autogenerated mock type for the Canvas type
+type Canvas struct {
+	mock.Mock
+}
+
+type Canvas_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Canvas) EXPECT() *Canvas_Expecter {
+	return &Canvas_Expecter{mock: &_m.Mock}
+}
+
+// AddDestination provides a mock function with given fields: x, y, name
+func (_m *Canvas) AddDestination(x float64, y float64, name string) {
+	_m.Called(x, y, name)
+}
+
+// Canvas_AddDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDestination'
+type Canvas_AddDestination_Call struct {
+	*mock.Call
+}
+
+// AddDestination is a helper method to define mock.On call
+//   - x float64
+//   - y float64
+//   - name string
+func (_e *Canvas_Expecter) AddDestination(x interface{}, y interface{}, name interface{}) *Canvas_AddDestination_Call {
+	return &Canvas_AddDestination_Call{Call: _e.mock.On("AddDestination", x, y, name)}
+}
+
+func (_c *Canvas_AddDestination_Call) Run(run func(x float64, y float64, name string)) *Canvas_AddDestination_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Canvas_AddDestination_Call) Return() *Canvas_AddDestination_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Canvas_AddDestination_Call) RunAndReturn(run func(float64, float64, string)) *Canvas_AddDestination_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddImage provides a mock function with given fields: x, y, width, height, img
+func (_m *Canvas) AddImage(x float64, y float64, width float64, height float64, img *entity.Image) error {
+	ret := _m.Called(x, y, width, height, img)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddImage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64, float64, float64, float64, *entity.Image) error); ok {
+		r0 = rf(x, y, width, height, img)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Canvas_AddImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddImage'
+type Canvas_AddImage_Call struct {
+	*mock.Call
+}
+
+// AddImage is a helper method to define mock.On call
+//   - x float64
+//   - y float64
+//   - width float64
+//   - height float64
+//   - img *entity.Image
+func (_e *Canvas_Expecter) AddImage(x interface{}, y interface{}, width interface{}, height interface{}, img interface{}) *Canvas_AddImage_Call {
+	return &Canvas_AddImage_Call{Call: _e.mock.On("AddImage", x, y, width, height, img)}
+}
+
+func (_c *Canvas_AddImage_Call) Run(run func(x float64, y float64, width float64, height float64, img *entity.Image)) *Canvas_AddImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(*entity.Image))
+	})
+	return _c
+}
+
+func (_c *Canvas_AddImage_Call) Return(_a0 error) *Canvas_AddImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Canvas_AddImage_Call) RunAndReturn(run func(float64, float64, float64, float64, *entity.Image) error) *Canvas_AddImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddLine provides a mock function with given fields: x1, y1, x2, y2, prop
+func (_m *Canvas) AddLine(x1 float64, y1 float64, x2 float64, y2 float64, prop *props.Line) {
+	_m.Called(x1, y1, x2, y2, prop)
+}
+
+// Canvas_AddLine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddLine'
+type Canvas_AddLine_Call struct {
+	*mock.Call
+}
+
+// AddLine is a helper method to define mock.On call
+//   - x1 float64
+//   - y1 float64
+//   - x2 float64
+//   - y2 float64
+//   - prop *props.Line
+func (_e *Canvas_Expecter) AddLine(x1 interface{}, y1 interface{}, x2 interface{}, y2 interface{}, prop interface{}) *Canvas_AddLine_Call {
+	return &Canvas_AddLine_Call{Call: _e.mock.On("AddLine", x1, y1, x2, y2, prop)}
+}
+
+func (_c *Canvas_AddLine_Call) Run(run func(x1 float64, y1 float64, x2 float64, y2 float64, prop *props.Line)) *Canvas_AddLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(*props.Line))
+	})
+	return _c
+}
+
+func (_c *Canvas_AddLine_Call) Return() *Canvas_AddLine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Canvas_AddLine_Call) RunAndReturn(run func(float64, float64, float64, float64, *props.Line)) *Canvas_AddLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPage provides a mock function with given fields: width, height
+func (_m *Canvas) AddPage(width float64, height float64) {
+	_m.Called(width, height)
+}
+
+// Canvas_AddPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPage'
+type Canvas_AddPage_Call struct {
+	*mock.Call
+}
+
+// AddPage is a helper method to define mock.On call
+//   - width float64
+//   - height float64
+func (_e *Canvas_Expecter) AddPage(width interface{}, height interface{}) *Canvas_AddPage_Call {
+	return &Canvas_AddPage_Call{Call: _e.mock.On("AddPage", width, height)}
+}
+
+func (_c *Canvas_AddPage_Call) Run(run func(width float64, height float64)) *Canvas_AddPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64))
+	})
+	return _c
+}
+
+func (_c *Canvas_AddPage_Call) Return() *Canvas_AddPage_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Canvas_AddPage_Call) RunAndReturn(run func(float64, float64)) *Canvas_AddPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRect provides a mock function with given fields: x, y, width, height, color
+func (_m *Canvas) AddRect(x float64, y float64, width float64, height float64, color *props.Color) {
+	_m.Called(x, y, width, height, color)
+}
+
+// Canvas_AddRect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRect'
+type Canvas_AddRect_Call struct {
+	*mock.Call
+}
+
+// AddRect is a helper method to define mock.On call
+//   - x float64
+//   - y float64
+//   - width float64
+//   - height float64
+//   - color *props.Color
+func (_e *Canvas_Expecter) AddRect(x interface{}, y interface{}, width interface{}, height interface{}, color interface{}) *Canvas_AddRect_Call {
+	return &Canvas_AddRect_Call{Call: _e.mock.On("AddRect", x, y, width, height, color)}
+}
+
+func (_c *Canvas_AddRect_Call) Run(run func(x float64, y float64, width float64, height float64, color *props.Color)) *Canvas_AddRect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(*props.Color))
+	})
+	return _c
+}
+
+func (_c *Canvas_AddRect_Call) Return() *Canvas_AddRect_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Canvas_AddRect_Call) RunAndReturn(run func(float64, float64, float64, float64, *props.Color)) *Canvas_AddRect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddText provides a mock function with given fields: x, y, text, fontSize, prop, link
+func (_m *Canvas) AddText(x float64, y float64, text string, fontSize float64, prop *props.Text, link string) {
+	_m.Called(x, y, text, fontSize, prop, link)
+}
+
+// Canvas_AddText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddText'
+type Canvas_AddText_Call struct {
+	*mock.Call
+}
+
+// AddText is a helper method to define mock.On call
+//   - x float64
+//   - y float64
+//   - text string
+//   - fontSize float64
+//   - prop *props.Text
+//   - link string
+func (_e *Canvas_Expecter) AddText(x interface{}, y interface{}, text interface{}, fontSize interface{}, prop interface{}, link interface{}) *Canvas_AddText_Call {
+	return &Canvas_AddText_Call{Call: _e.mock.On("AddText", x, y, text, fontSize, prop, link)}
+}
+
+func (_c *Canvas_AddText_Call) Run(run func(x float64, y float64, text string, fontSize float64, prop *props.Text, link string)) *Canvas_AddText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(string), args[3].(float64), args[4].(*props.Text), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *Canvas_AddText_Call) Return() *Canvas_AddText_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Canvas_AddText_Call) RunAndReturn(run func(float64, float64, string, float64, *props.Text, string)) *Canvas_AddText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginTransform provides a mock function with given fields: x, y, angle, opacity
+func (_m *Canvas) BeginTransform(x float64, y float64, angle float64, opacity float64) {
+	_m.Called(x, y, angle, opacity)
+}
+
+// Canvas_BeginTransform_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTransform'
+type Canvas_BeginTransform_Call struct {
+	*mock.Call
+}
+
+// BeginTransform is a helper method to define mock.On call
+//   - x float64
+//   - y float64
+//   - angle float64
+//   - opacity float64
+func (_e *Canvas_Expecter) BeginTransform(x interface{}, y interface{}, angle interface{}, opacity interface{}) *Canvas_BeginTransform_Call {
+	return &Canvas_BeginTransform_Call{Call: _e.mock.On("BeginTransform", x, y, angle, opacity)}
+}
+
+func (_c *Canvas_BeginTransform_Call) Run(run func(x float64, y float64, angle float64, opacity float64)) *Canvas_BeginTransform_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *Canvas_BeginTransform_Call) Return() *Canvas_BeginTransform_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Canvas_BeginTransform_Call) RunAndReturn(run func(float64, float64, float64, float64)) *Canvas_BeginTransform_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EndTransform provides a mock function with given fields:
+func (_m *Canvas) EndTransform() {
+	_m.Called()
+}
+
+// Canvas_EndTransform_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndTransform'
+type Canvas_EndTransform_Call struct {
+	*mock.Call
+}
+
+// EndTransform is a helper method to define mock.On call
+func (_e *Canvas_Expecter) EndTransform() *Canvas_EndTransform_Call {
+	return &Canvas_EndTransform_Call{Call: _e.mock.On("EndTransform")}
+}
+
+func (_c *Canvas_EndTransform_Call) Run(run func()) *Canvas_EndTransform_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Canvas_EndTransform_Call) Return() *Canvas_EndTransform_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Canvas_EndTransform_Call) RunAndReturn(run func()) *Canvas_EndTransform_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMetadata provides a mock function with given fields: metadata
+func (_m *Canvas) SetMetadata(metadata *entity.Metadata) {
+	_m.Called(metadata)
+}
+
+// Canvas_SetMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMetadata'
+type Canvas_SetMetadata_Call struct {
+	*mock.Call
+}
+
+// SetMetadata is a helper method to define mock.On call
+//   - metadata *entity.Metadata
+func (_e *Canvas_Expecter) SetMetadata(metadata interface{}) *Canvas_SetMetadata_Call {
+	return &Canvas_SetMetadata_Call{Call: _e.mock.On("SetMetadata", metadata)}
+}
+
+func (_c *Canvas_SetMetadata_Call) Run(run func(metadata *entity.Metadata)) *Canvas_SetMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Metadata))
+	})
+	return _c
+}
+
+func (_c *Canvas_SetMetadata_Call) Return() *Canvas_SetMetadata_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Canvas_SetMetadata_Call) RunAndReturn(run func(*entity.Metadata)) *Canvas_SetMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Write provides a mock function with given fields: w
+func (_m *Canvas) Write(w io.Writer) error {
+	ret := _m.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Write")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer) error); ok {
+		r0 = rf(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Canvas_Write_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Write'
+type Canvas_Write_Call struct {
+	*mock.Call
+}
+
+// Write is a helper method to define mock.On call
+//   - w io.Writer
+func (_e *Canvas_Expecter) Write(w interface{}) *Canvas_Write_Call {
+	return &Canvas_Write_Call{Call: _e.mock.On("Write", w)}
+}
+
+func (_c *Canvas_Write_Call) Run(run func(w io.Writer)) *Canvas_Write_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *Canvas_Write_Call) Return(_a0 error) *Canvas_Write_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Canvas_Write_Call) RunAndReturn(run func(io.Writer) error) *Canvas_Write_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCanvas creates a new instance of Canvas. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCanvas(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *Canvas {
+	mock := &Canvas{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
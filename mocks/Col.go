@@ -87,6 +87,114 @@ func (_c *Col_Add_Call) RunAndReturn(run func(...core.Component) core.Col) *Col_
 	return _c
 }
 
+// AddRows provides a mock function with given fields: rows
+func (_m *Col) AddRows(rows ...core.Row) core.Col {
+	_va := make([]interface{}, len(rows))
+	for _i := range rows {
+		_va[_i] = rows[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRows")
+	}
+
+	var r0 core.Col
+	if rf, ok := ret.Get(0).(func(...core.Row) core.Col); ok {
+		r0 = rf(rows...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Col)
+		}
+	}
+
+	return r0
+}
+
+// Col_AddRows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRows'
+type Col_AddRows_Call struct {
+	*mock.Call
+}
+
+// AddRows is a helper method to define mock.On call
+//   - rows ...core.Row
+func (_e *Col_Expecter) AddRows(rows ...interface{}) *Col_AddRows_Call {
+	return &Col_AddRows_Call{Call: _e.mock.On("AddRows",
+		append([]interface{}{}, rows...)...)}
+}
+
+func (_c *Col_AddRows_Call) Run(run func(rows ...core.Row)) *Col_AddRows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Row, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Row)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Col_AddRows_Call) Return(_a0 core.Col) *Col_AddRows_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Col_AddRows_Call) RunAndReturn(run func(...core.Row) core.Col) *Col_AddRows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHeight provides a mock function with given fields: provider, cell
+func (_m *Col) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	ret := _m.Called(provider, cell)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeight")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(core.Provider, *entity.Cell) float64); ok {
+		r0 = rf(provider, cell)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Col_GetHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeight'
+type Col_GetHeight_Call struct {
+	*mock.Call
+}
+
+// GetHeight is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell *entity.Cell
+func (_e *Col_Expecter) GetHeight(provider interface{}, cell interface{}) *Col_GetHeight_Call {
+	return &Col_GetHeight_Call{Call: _e.mock.On("GetHeight", provider, cell)}
+}
+
+func (_c *Col_GetHeight_Call) Run(run func(provider core.Provider, cell *entity.Cell)) *Col_GetHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *Col_GetHeight_Call) Return(_a0 float64) *Col_GetHeight_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Col_GetHeight_Call) RunAndReturn(run func(core.Provider, *entity.Cell) float64) *Col_GetHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSize provides a mock function with given fields:
 func (_m *Col) GetSize() int {
 	ret := _m.Called()
@@ -247,6 +355,123 @@ func (_c *Col_SetConfig_Call) RunAndReturn(run func(*entity.Config)) *Col_SetCon
 	return _c
 }
 
+// Split provides a mock function with given fields: provider, cell, height
+func (_m *Col) Split(provider core.Provider, cell *entity.Cell, height float64) (core.Col, core.Col, bool) {
+	ret := _m.Called(provider, cell, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Split")
+	}
+
+	var r0 core.Col
+	var r1 core.Col
+	var r2 bool
+	if rf, ok := ret.Get(0).(func(core.Provider, *entity.Cell, float64) (core.Col, core.Col, bool)); ok {
+		return rf(provider, cell, height)
+	}
+	if rf, ok := ret.Get(0).(func(core.Provider, *entity.Cell, float64) core.Col); ok {
+		r0 = rf(provider, cell, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Col)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(core.Provider, *entity.Cell, float64) core.Col); ok {
+		r1 = rf(provider, cell, height)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(core.Col)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(core.Provider, *entity.Cell, float64) bool); ok {
+		r2 = rf(provider, cell, height)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	return r0, r1, r2
+}
+
+// Col_Split_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Split'
+type Col_Split_Call struct {
+	*mock.Call
+}
+
+// Split is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell *entity.Cell
+//   - height float64
+func (_e *Col_Expecter) Split(provider interface{}, cell interface{}, height interface{}) *Col_Split_Call {
+	return &Col_Split_Call{Call: _e.mock.On("Split", provider, cell, height)}
+}
+
+func (_c *Col_Split_Call) Run(run func(provider core.Provider, cell *entity.Cell, height float64)) *Col_Split_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(*entity.Cell), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Col_Split_Call) Return(_a0 core.Col, _a1 core.Col, _a2 bool) *Col_Split_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Col_Split_Call) RunAndReturn(run func(core.Provider, *entity.Cell, float64) (core.Col, core.Col, bool)) *Col_Split_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithStack provides a mock function with given fields: stack
+func (_m *Col) WithStack(stack *props.Stack) core.Col {
+	ret := _m.Called(stack)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithStack")
+	}
+
+	var r0 core.Col
+	if rf, ok := ret.Get(0).(func(*props.Stack) core.Col); ok {
+		r0 = rf(stack)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Col)
+		}
+	}
+
+	return r0
+}
+
+// Col_WithStack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithStack'
+type Col_WithStack_Call struct {
+	*mock.Call
+}
+
+// WithStack is a helper method to define mock.On call
+//   - stack *props.Stack
+func (_e *Col_Expecter) WithStack(stack interface{}) *Col_WithStack_Call {
+	return &Col_WithStack_Call{Call: _e.mock.On("WithStack", stack)}
+}
+
+func (_c *Col_WithStack_Call) Run(run func(stack *props.Stack)) *Col_WithStack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*props.Stack))
+	})
+	return _c
+}
+
+func (_c *Col_WithStack_Call) Return(_a0 core.Col) *Col_WithStack_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Col_WithStack_Call) RunAndReturn(run func(*props.Stack) core.Col) *Col_WithStack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithStyle provides a mock function with given fields: style
 func (_m *Col) WithStyle(style *props.Cell) core.Col {
 	ret := _m.Called(style)
@@ -24,6 +24,53 @@ func (_m *Component) EXPECT() *Component_Expecter {
 	return &Component_Expecter{mock: &_m.Mock}
 }
 
+// GetHeight provides a mock function with given fields: provider, cell
+func (_m *Component) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	ret := _m.Called(provider, cell)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeight")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(core.Provider, *entity.Cell) float64); ok {
+		r0 = rf(provider, cell)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Component_GetHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeight'
+type Component_GetHeight_Call struct {
+	*mock.Call
+}
+
+// GetHeight is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell *entity.Cell
+func (_e *Component_Expecter) GetHeight(provider interface{}, cell interface{}) *Component_GetHeight_Call {
+	return &Component_GetHeight_Call{Call: _e.mock.On("GetHeight", provider, cell)}
+}
+
+func (_c *Component_GetHeight_Call) Run(run func(provider core.Provider, cell *entity.Cell)) *Component_GetHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *Component_GetHeight_Call) Return(_a0 float64) *Component_GetHeight_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Component_GetHeight_Call) RunAndReturn(run func(core.Provider, *entity.Cell) float64) *Component_GetHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStructure provides a mock function with given fields:
 func (_m *Component) GetStructure() *node.Node[core.Structure] {
 	ret := _m.Called()
@@ -0,0 +1,135 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// Link is an autogenerated mock type for the Link type
+type Link struct {
+	mock.Mock
+}
+
+type Link_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Link) EXPECT() *Link_Expecter {
+	return &Link_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: name, x, y, width, height
+func (_m *Link) Add(name string, x float64, y float64, width float64, height float64) {
+	_m.Called(name, x, y, width, height)
+}
+
+// Link_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type Link_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - name string
+//   - x float64
+//   - y float64
+//   - width float64
+//   - height float64
+func (_e *Link_Expecter) Add(name interface{}, x interface{}, y interface{}, width interface{}, height interface{}) *Link_Add_Call {
+	return &Link_Add_Call{Call: _e.mock.On("Add", name, x, y, width, height)}
+}
+
+func (_c *Link_Add_Call) Run(run func(name string, x float64, y float64, width float64, height float64)) *Link_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(float64))
+	})
+	return _c
+}
+
+func (_c *Link_Add_Call) Return() *Link_Add_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Link_Add_Call) RunAndReturn(run func(string, float64, float64, float64, float64)) *Link_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDestination provides a mock function with given fields: name
+func (_m *Link) SetDestination(name string) {
+	_m.Called(name)
+}
+
+// Link_SetDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDestination'
+type Link_SetDestination_Call struct {
+	*mock.Call
+}
+
+// SetDestination is a helper method to define mock.On call
+//   - name string
+func (_e *Link_Expecter) SetDestination(name interface{}) *Link_SetDestination_Call {
+	return &Link_SetDestination_Call{Call: _e.mock.On("SetDestination", name)}
+}
+
+func (_c *Link_SetDestination_Call) Run(run func(name string)) *Link_SetDestination_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Link_SetDestination_Call) Return() *Link_SetDestination_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Link_SetDestination_Call) RunAndReturn(run func(string)) *Link_SetDestination_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMissingDestinations provides a mock function with given fields:
+func (_m *Link) SetMissingDestinations() {
+	_m.Called()
+}
+
+// Link_SetMissingDestinations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMissingDestinations'
+type Link_SetMissingDestinations_Call struct {
+	*mock.Call
+}
+
+// SetMissingDestinations is a helper method to define mock.On call
+func (_e *Link_Expecter) SetMissingDestinations() *Link_SetMissingDestinations_Call {
+	return &Link_SetMissingDestinations_Call{Call: _e.mock.On("SetMissingDestinations")}
+}
+
+func (_c *Link_SetMissingDestinations_Call) Run(run func()) *Link_SetMissingDestinations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Link_SetMissingDestinations_Call) Return() *Link_SetMissingDestinations_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Link_SetMissingDestinations_Call) RunAndReturn(run func()) *Link_SetMissingDestinations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLink creates a new instance of Link. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLink(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *Link {
+	mock := &Link{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,10 +3,22 @@
 package mocks
 
 import (
+	context "context"
+
 	core "github.com/miguelbernadi/maroto/v2/pkg/core"
+	entity "github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 
 	node "github.com/johnfercher/go-tree/node"
+
+	numbering "github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+
+	pagebreak "github.com/miguelbernadi/maroto/v2/pkg/consts/pagebreak"
+
+	pagevariant "github.com/miguelbernadi/maroto/v2/pkg/consts/pagevariant"
 )
 
 // Maroto is an autogenerated mock type for the Maroto type
@@ -22,6 +34,98 @@ func (_m *Maroto) EXPECT() *Maroto_Expecter {
 	return &Maroto_Expecter{mock: &_m.Mock}
 }
 
+// AddGroup provides a mock function with given fields: rows
+func (_m *Maroto) AddGroup(rows ...core.Row) {
+	_va := make([]interface{}, len(rows))
+	for _i := range rows {
+		_va[_i] = rows[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// Maroto_AddGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGroup'
+type Maroto_AddGroup_Call struct {
+	*mock.Call
+}
+
+// AddGroup is a helper method to define mock.On call
+//   - rows ...core.Row
+func (_e *Maroto_Expecter) AddGroup(rows ...interface{}) *Maroto_AddGroup_Call {
+	return &Maroto_AddGroup_Call{Call: _e.mock.On("AddGroup",
+		append([]interface{}{}, rows...)...)}
+}
+
+func (_c *Maroto_AddGroup_Call) Run(run func(rows ...core.Row)) *Maroto_AddGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Row, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Row)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Maroto_AddGroup_Call) Return() *Maroto_AddGroup_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Maroto_AddGroup_Call) RunAndReturn(run func(...core.Row)) *Maroto_AddGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPageBreak provides a mock function with given fields: breakType
+func (_m *Maroto) AddPageBreak(breakType ...pagebreak.Type) {
+	_va := make([]interface{}, len(breakType))
+	for _i := range breakType {
+		_va[_i] = breakType[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// Maroto_AddPageBreak_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPageBreak'
+type Maroto_AddPageBreak_Call struct {
+	*mock.Call
+}
+
+// AddPageBreak is a helper method to define mock.On call
+//   - breakType ...pagebreak.Type
+func (_e *Maroto_Expecter) AddPageBreak(breakType ...interface{}) *Maroto_AddPageBreak_Call {
+	return &Maroto_AddPageBreak_Call{Call: _e.mock.On("AddPageBreak",
+		append([]interface{}{}, breakType...)...)}
+}
+
+func (_c *Maroto_AddPageBreak_Call) Run(run func(breakType ...pagebreak.Type)) *Maroto_AddPageBreak_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]pagebreak.Type, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(pagebreak.Type)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Maroto_AddPageBreak_Call) Return() *Maroto_AddPageBreak_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Maroto_AddPageBreak_Call) RunAndReturn(run func(...pagebreak.Type)) *Maroto_AddPageBreak_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddPages provides a mock function with given fields: pages
 func (_m *Maroto) AddPages(pages ...core.Page) {
 	_va := make([]interface{}, len(pages))
@@ -177,6 +281,97 @@ func (_c *Maroto_AddRows_Call) RunAndReturn(run func(...core.Row)) *Maroto_AddRo
 	return _c
 }
 
+// AddTOC provides a mock function with given fields: toc
+func (_m *Maroto) AddTOC(toc core.TOC) error {
+	ret := _m.Called(toc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTOC")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(core.TOC) error); ok {
+		r0 = rf(toc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Maroto_AddTOC_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTOC'
+type Maroto_AddTOC_Call struct {
+	*mock.Call
+}
+
+// AddTOC is a helper method to define mock.On call
+//   - toc core.TOC
+func (_e *Maroto_Expecter) AddTOC(toc interface{}) *Maroto_AddTOC_Call {
+	return &Maroto_AddTOC_Call{Call: _e.mock.On("AddTOC", toc)}
+}
+
+func (_c *Maroto_AddTOC_Call) Run(run func(toc core.TOC)) *Maroto_AddTOC_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.TOC))
+	})
+	return _c
+}
+
+func (_c *Maroto_AddTOC_Call) Return(_a0 error) *Maroto_AddTOC_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Maroto_AddTOC_Call) RunAndReturn(run func(core.TOC) error) *Maroto_AddTOC_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cursor provides a mock function with given fields:
+func (_m *Maroto) Cursor() entity.Cursor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cursor")
+	}
+
+	var r0 entity.Cursor
+	if rf, ok := ret.Get(0).(func() entity.Cursor); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(entity.Cursor)
+	}
+
+	return r0
+}
+
+// Maroto_Cursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cursor'
+type Maroto_Cursor_Call struct {
+	*mock.Call
+}
+
+// Cursor is a helper method to define mock.On call
+func (_e *Maroto_Expecter) Cursor() *Maroto_Cursor_Call {
+	return &Maroto_Cursor_Call{Call: _e.mock.On("Cursor")}
+}
+
+func (_c *Maroto_Cursor_Call) Run(run func()) *Maroto_Cursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Maroto_Cursor_Call) Return(_a0 entity.Cursor) *Maroto_Cursor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Maroto_Cursor_Call) RunAndReturn(run func() entity.Cursor) *Maroto_Cursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FitlnCurrentPage provides a mock function with given fields: heightNewLine
 func (_m *Maroto) FitlnCurrentPage(heightNewLine float64) bool {
 	ret := _m.Called(heightNewLine)
@@ -280,6 +475,110 @@ func (_c *Maroto_Generate_Call) RunAndReturn(run func() (core.Document, error))
 	return _c
 }
 
+// GenerateContext provides a mock function with given fields: ctx
+func (_m *Maroto) GenerateContext(ctx context.Context) (core.Document, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateContext")
+	}
+
+	var r0 core.Document
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (core.Document, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) core.Document); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Document)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Maroto_GenerateContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateContext'
+type Maroto_GenerateContext_Call struct {
+	*mock.Call
+}
+
+// GenerateContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Maroto_Expecter) GenerateContext(ctx interface{}) *Maroto_GenerateContext_Call {
+	return &Maroto_GenerateContext_Call{Call: _e.mock.On("GenerateContext", ctx)}
+}
+
+func (_c *Maroto_GenerateContext_Call) Run(run func(ctx context.Context)) *Maroto_GenerateContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Maroto_GenerateContext_Call) Return(_a0 core.Document, _a1 error) *Maroto_GenerateContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Maroto_GenerateContext_Call) RunAndReturn(run func(context.Context) (core.Document, error)) *Maroto_GenerateContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateTo provides a mock function with given fields: w
+func (_m *Maroto) GenerateTo(w io.Writer) error {
+	ret := _m.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer) error); ok {
+		r0 = rf(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Maroto_GenerateTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateTo'
+type Maroto_GenerateTo_Call struct {
+	*mock.Call
+}
+
+// GenerateTo is a helper method to define mock.On call
+//   - w io.Writer
+func (_e *Maroto_Expecter) GenerateTo(w interface{}) *Maroto_GenerateTo_Call {
+	return &Maroto_GenerateTo_Call{Call: _e.mock.On("GenerateTo", w)}
+}
+
+func (_c *Maroto_GenerateTo_Call) Run(run func(w io.Writer)) *Maroto_GenerateTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *Maroto_GenerateTo_Call) Return(_a0 error) *Maroto_GenerateTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Maroto_GenerateTo_Call) RunAndReturn(run func(io.Writer) error) *Maroto_GenerateTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStructure provides a mock function with given fields:
 func (_m *Maroto) GetStructure() *node.Node[core.Structure] {
 	ret := _m.Called()
@@ -386,6 +685,113 @@ func (_c *Maroto_RegisterFooter_Call) RunAndReturn(run func(...core.Row) error)
 	return _c
 }
 
+// RegisterFooterFunc provides a mock function with given fields: build
+func (_m *Maroto) RegisterFooterFunc(build func(entity.PageContext) []core.Row) error {
+	ret := _m.Called(build)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterFooterFunc")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(entity.PageContext) []core.Row) error); ok {
+		r0 = rf(build)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Maroto_RegisterFooterFunc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterFooterFunc'
+type Maroto_RegisterFooterFunc_Call struct {
+	*mock.Call
+}
+
+// RegisterFooterFunc is a helper method to define mock.On call
+//   - build func(entity.PageContext) []core.Row
+func (_e *Maroto_Expecter) RegisterFooterFunc(build interface{}) *Maroto_RegisterFooterFunc_Call {
+	return &Maroto_RegisterFooterFunc_Call{Call: _e.mock.On("RegisterFooterFunc", build)}
+}
+
+func (_c *Maroto_RegisterFooterFunc_Call) Run(run func(build func(entity.PageContext) []core.Row)) *Maroto_RegisterFooterFunc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(entity.PageContext) []core.Row))
+	})
+	return _c
+}
+
+func (_c *Maroto_RegisterFooterFunc_Call) Return(_a0 error) *Maroto_RegisterFooterFunc_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Maroto_RegisterFooterFunc_Call) RunAndReturn(run func(func(entity.PageContext) []core.Row) error) *Maroto_RegisterFooterFunc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterFooterVariant provides a mock function with given fields: variant, rows
+func (_m *Maroto) RegisterFooterVariant(variant pagevariant.Type, rows ...core.Row) error {
+	_va := make([]interface{}, len(rows))
+	for _i := range rows {
+		_va[_i] = rows[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, variant)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterFooterVariant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(pagevariant.Type, ...core.Row) error); ok {
+		r0 = rf(variant, rows...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Maroto_RegisterFooterVariant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterFooterVariant'
+type Maroto_RegisterFooterVariant_Call struct {
+	*mock.Call
+}
+
+// RegisterFooterVariant is a helper method to define mock.On call
+//   - variant pagevariant.Type
+//   - rows ...core.Row
+func (_e *Maroto_Expecter) RegisterFooterVariant(variant interface{}, rows ...interface{}) *Maroto_RegisterFooterVariant_Call {
+	return &Maroto_RegisterFooterVariant_Call{Call: _e.mock.On("RegisterFooterVariant",
+		append([]interface{}{variant}, rows...)...)}
+}
+
+func (_c *Maroto_RegisterFooterVariant_Call) Run(run func(variant pagevariant.Type, rows ...core.Row)) *Maroto_RegisterFooterVariant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Row, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Row)
+			}
+		}
+		run(args[0].(pagevariant.Type), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Maroto_RegisterFooterVariant_Call) Return(_a0 error) *Maroto_RegisterFooterVariant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Maroto_RegisterFooterVariant_Call) RunAndReturn(run func(pagevariant.Type, ...core.Row) error) *Maroto_RegisterFooterVariant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegisterHeader provides a mock function with given fields: rows
 func (_m *Maroto) RegisterHeader(rows ...core.Row) error {
 	_va := make([]interface{}, len(rows))
@@ -445,6 +851,160 @@ func (_c *Maroto_RegisterHeader_Call) RunAndReturn(run func(...core.Row) error)
 	return _c
 }
 
+// RegisterHeaderFunc provides a mock function with given fields: build
+func (_m *Maroto) RegisterHeaderFunc(build func(entity.PageContext) []core.Row) error {
+	ret := _m.Called(build)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterHeaderFunc")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(entity.PageContext) []core.Row) error); ok {
+		r0 = rf(build)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Maroto_RegisterHeaderFunc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterHeaderFunc'
+type Maroto_RegisterHeaderFunc_Call struct {
+	*mock.Call
+}
+
+// RegisterHeaderFunc is a helper method to define mock.On call
+//   - build func(entity.PageContext) []core.Row
+func (_e *Maroto_Expecter) RegisterHeaderFunc(build interface{}) *Maroto_RegisterHeaderFunc_Call {
+	return &Maroto_RegisterHeaderFunc_Call{Call: _e.mock.On("RegisterHeaderFunc", build)}
+}
+
+func (_c *Maroto_RegisterHeaderFunc_Call) Run(run func(build func(entity.PageContext) []core.Row)) *Maroto_RegisterHeaderFunc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(entity.PageContext) []core.Row))
+	})
+	return _c
+}
+
+func (_c *Maroto_RegisterHeaderFunc_Call) Return(_a0 error) *Maroto_RegisterHeaderFunc_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Maroto_RegisterHeaderFunc_Call) RunAndReturn(run func(func(entity.PageContext) []core.Row) error) *Maroto_RegisterHeaderFunc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterHeaderVariant provides a mock function with given fields: variant, rows
+func (_m *Maroto) RegisterHeaderVariant(variant pagevariant.Type, rows ...core.Row) error {
+	_va := make([]interface{}, len(rows))
+	for _i := range rows {
+		_va[_i] = rows[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, variant)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterHeaderVariant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(pagevariant.Type, ...core.Row) error); ok {
+		r0 = rf(variant, rows...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Maroto_RegisterHeaderVariant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterHeaderVariant'
+type Maroto_RegisterHeaderVariant_Call struct {
+	*mock.Call
+}
+
+// RegisterHeaderVariant is a helper method to define mock.On call
+//   - variant pagevariant.Type
+//   - rows ...core.Row
+func (_e *Maroto_Expecter) RegisterHeaderVariant(variant interface{}, rows ...interface{}) *Maroto_RegisterHeaderVariant_Call {
+	return &Maroto_RegisterHeaderVariant_Call{Call: _e.mock.On("RegisterHeaderVariant",
+		append([]interface{}{variant}, rows...)...)}
+}
+
+func (_c *Maroto_RegisterHeaderVariant_Call) Run(run func(variant pagevariant.Type, rows ...core.Row)) *Maroto_RegisterHeaderVariant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Row, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Row)
+			}
+		}
+		run(args[0].(pagevariant.Type), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Maroto_RegisterHeaderVariant_Call) Return(_a0 error) *Maroto_RegisterHeaderVariant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Maroto_RegisterHeaderVariant_Call) RunAndReturn(run func(pagevariant.Type, ...core.Row) error) *Maroto_RegisterHeaderVariant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartSection provides a mock function with given fields: name, numberingStyle
+func (_m *Maroto) StartSection(name string, numberingStyle numbering.Type) error {
+	ret := _m.Called(name, numberingStyle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartSection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, numbering.Type) error); ok {
+		r0 = rf(name, numberingStyle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Maroto_StartSection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartSection'
+type Maroto_StartSection_Call struct {
+	*mock.Call
+}
+
+// StartSection is a helper method to define mock.On call
+//   - name string
+//   - numberingStyle numbering.Type
+func (_e *Maroto_Expecter) StartSection(name interface{}, numberingStyle interface{}) *Maroto_StartSection_Call {
+	return &Maroto_StartSection_Call{Call: _e.mock.On("StartSection", name, numberingStyle)}
+}
+
+func (_c *Maroto_StartSection_Call) Run(run func(name string, numberingStyle numbering.Type)) *Maroto_StartSection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(numbering.Type))
+	})
+	return _c
+}
+
+func (_c *Maroto_StartSection_Call) Return(_a0 error) *Maroto_StartSection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Maroto_StartSection_Call) RunAndReturn(run func(string, numbering.Type) error) *Maroto_StartSection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMaroto creates a new instance of Maroto. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMaroto(t interface {
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	node "github.com/johnfercher/go-tree/node"
+
+	props "github.com/miguelbernadi/maroto/v2/pkg/props"
 )
 
 // Page is an autogenerated mock type for the Page type
@@ -130,6 +132,51 @@ func (_c *Page_GetNumber_Call) RunAndReturn(run func() int) *Page_GetNumber_Call
 	return _c
 }
 
+// GetProp provides a mock function with given fields:
+func (_m *Page) GetProp() props.Page {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProp")
+	}
+
+	var r0 props.Page
+	if rf, ok := ret.Get(0).(func() props.Page); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(props.Page)
+	}
+
+	return r0
+}
+
+// Page_GetProp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProp'
+type Page_GetProp_Call struct {
+	*mock.Call
+}
+
+// GetProp is a helper method to define mock.On call
+func (_e *Page_Expecter) GetProp() *Page_GetProp_Call {
+	return &Page_GetProp_Call{Call: _e.mock.On("GetProp")}
+}
+
+func (_c *Page_GetProp_Call) Run(run func()) *Page_GetProp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Page_GetProp_Call) Return(_a0 props.Page) *Page_GetProp_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Page_GetProp_Call) RunAndReturn(run func() props.Page) *Page_GetProp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRows provides a mock function with given fields:
 func (_m *Page) GetRows() []core.Row {
 	ret := _m.Called()
@@ -325,6 +372,39 @@ func (_c *Page_SetNumber_Call) RunAndReturn(run func(int, int)) *Page_SetNumber_
 	return _c
 }
 
+// SetSection provides a mock function with given fields: section
+func (_m *Page) SetSection(section entity.Section) {
+	_m.Called(section)
+}
+
+// Page_SetSection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSection'
+type Page_SetSection_Call struct {
+	*mock.Call
+}
+
+// SetSection is a helper method to define mock.On call
+//   - section entity.Section
+func (_e *Page_Expecter) SetSection(section interface{}) *Page_SetSection_Call {
+	return &Page_SetSection_Call{Call: _e.mock.On("SetSection", section)}
+}
+
+func (_c *Page_SetSection_Call) Run(run func(section entity.Section)) *Page_SetSection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.Section))
+	})
+	return _c
+}
+
+func (_c *Page_SetSection_Call) Return() *Page_SetSection_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Page_SetSection_Call) RunAndReturn(run func(entity.Section)) *Page_SetSection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPage creates a new instance of Page. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPage(t interface {
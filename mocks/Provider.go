@@ -6,6 +6,8 @@ import (
 	extension "github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
 	entity "github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 
 	props "github.com/miguelbernadi/maroto/v2/pkg/props"
@@ -95,6 +97,73 @@ func (_c *Provider_AddBarCode_Call) RunAndReturn(run func(string, *entity.Cell,
 	return _c
 }
 
+// AddBookmark provides a mock function with given fields: title, level
+func (_m *Provider) AddBookmark(title string, level int) {
+	_m.Called(title, level)
+}
+
+// Provider_AddBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBookmark'
+type Provider_AddBookmark_Call struct {
+	*mock.Call
+}
+
+// AddBookmark is a helper method to define mock.On call
+//   - title string
+//   - level int
+func (_e *Provider_Expecter) AddBookmark(title interface{}, level interface{}) *Provider_AddBookmark_Call {
+	return &Provider_AddBookmark_Call{Call: _e.mock.On("AddBookmark", title, level)}
+}
+
+func (_c *Provider_AddBookmark_Call) Run(run func(title string, level int)) *Provider_AddBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Provider_AddBookmark_Call) Return() *Provider_AddBookmark_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddBookmark_Call) RunAndReturn(run func(string, int)) *Provider_AddBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddDestination provides a mock function with given fields: name
+func (_m *Provider) AddDestination(name string) {
+	_m.Called(name)
+}
+
+// Provider_AddDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDestination'
+type Provider_AddDestination_Call struct {
+	*mock.Call
+}
+
+// AddDestination is a helper method to define mock.On call
+//   - name string
+func (_e *Provider_Expecter) AddDestination(name interface{}) *Provider_AddDestination_Call {
+	return &Provider_AddDestination_Call{Call: _e.mock.On("AddDestination", name)}
+}
+
+func (_c *Provider_AddDestination_Call) Run(run func(name string)) *Provider_AddDestination_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Provider_AddDestination_Call) Return() *Provider_AddDestination_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddDestination_Call) RunAndReturn(run func(string)) *Provider_AddDestination_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddImageFromBytes provides a mock function with given fields: bytes, cell, prop, _a3
 func (_m *Provider) AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, _a3 extension.Type) {
 	_m.Called(bytes, cell, prop, _a3)
@@ -166,6 +235,42 @@ func (_c *Provider_AddImageFromFile_Call) RunAndReturn(run func(string, *entity.
 	return _c
 }
 
+// AddImageWatermark provides a mock function with given fields: bytes, cell, prop, _a3
+func (_m *Provider) AddImageWatermark(bytes []byte, cell *entity.Cell, prop *props.Watermark, _a3 extension.Type) {
+	_m.Called(bytes, cell, prop, _a3)
+}
+
+// Provider_AddImageWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddImageWatermark'
+type Provider_AddImageWatermark_Call struct {
+	*mock.Call
+}
+
+// AddImageWatermark is a helper method to define mock.On call
+//   - bytes []byte
+//   - cell *entity.Cell
+//   - prop *props.Watermark
+//   - _a3 extension.Type
+func (_e *Provider_Expecter) AddImageWatermark(bytes interface{}, cell interface{}, prop interface{}, _a3 interface{}) *Provider_AddImageWatermark_Call {
+	return &Provider_AddImageWatermark_Call{Call: _e.mock.On("AddImageWatermark", bytes, cell, prop, _a3)}
+}
+
+func (_c *Provider_AddImageWatermark_Call) Run(run func(bytes []byte, cell *entity.Cell, prop *props.Watermark, _a3 extension.Type)) *Provider_AddImageWatermark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(*entity.Cell), args[2].(*props.Watermark), args[3].(extension.Type))
+	})
+	return _c
+}
+
+func (_c *Provider_AddImageWatermark_Call) Return() *Provider_AddImageWatermark_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddImageWatermark_Call) RunAndReturn(run func([]byte, *entity.Cell, *props.Watermark, extension.Type)) *Provider_AddImageWatermark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddLine provides a mock function with given fields: cell, prop
 func (_m *Provider) AddLine(cell *entity.Cell, prop *props.Line) {
 	_m.Called(cell, prop)
@@ -235,6 +340,40 @@ func (_c *Provider_AddMatrixCode_Call) RunAndReturn(run func(string, *entity.Cel
 	return _c
 }
 
+// AddPage provides a mock function with given fields: width, height
+func (_m *Provider) AddPage(width float64, height float64) {
+	_m.Called(width, height)
+}
+
+// Provider_AddPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPage'
+type Provider_AddPage_Call struct {
+	*mock.Call
+}
+
+// AddPage is a helper method to define mock.On call
+//   - width float64
+//   - height float64
+func (_e *Provider_Expecter) AddPage(width interface{}, height interface{}) *Provider_AddPage_Call {
+	return &Provider_AddPage_Call{Call: _e.mock.On("AddPage", width, height)}
+}
+
+func (_c *Provider_AddPage_Call) Run(run func(width float64, height float64)) *Provider_AddPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64))
+	})
+	return _c
+}
+
+func (_c *Provider_AddPage_Call) Return() *Provider_AddPage_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddPage_Call) RunAndReturn(run func(float64, float64)) *Provider_AddPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddQrCode provides a mock function with given fields: code, cell, rect
 func (_m *Provider) AddQrCode(code string, cell *entity.Cell, rect *props.Rect) {
 	_m.Called(code, cell, rect)
@@ -305,6 +444,41 @@ func (_c *Provider_AddText_Call) RunAndReturn(run func(string, *entity.Cell, *pr
 	return _c
 }
 
+// AddTextWatermark provides a mock function with given fields: text, cell, prop
+func (_m *Provider) AddTextWatermark(text string, cell *entity.Cell, prop *props.Watermark) {
+	_m.Called(text, cell, prop)
+}
+
+// Provider_AddTextWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTextWatermark'
+type Provider_AddTextWatermark_Call struct {
+	*mock.Call
+}
+
+// AddTextWatermark is a helper method to define mock.On call
+//   - text string
+//   - cell *entity.Cell
+//   - prop *props.Watermark
+func (_e *Provider_Expecter) AddTextWatermark(text interface{}, cell interface{}, prop interface{}) *Provider_AddTextWatermark_Call {
+	return &Provider_AddTextWatermark_Call{Call: _e.mock.On("AddTextWatermark", text, cell, prop)}
+}
+
+func (_c *Provider_AddTextWatermark_Call) Run(run func(text string, cell *entity.Cell, prop *props.Watermark)) *Provider_AddTextWatermark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.Watermark))
+	})
+	return _c
+}
+
+func (_c *Provider_AddTextWatermark_Call) Return() *Provider_AddTextWatermark_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddTextWatermark_Call) RunAndReturn(run func(string, *entity.Cell, *props.Watermark)) *Provider_AddTextWatermark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCol provides a mock function with given fields: width, height, config, prop
 func (_m *Provider) CreateCol(width float64, height float64, config *entity.Config, prop *props.Cell) {
 	_m.Called(width, height, config, prop)
@@ -431,6 +605,430 @@ func (_c *Provider_GenerateBytes_Call) RunAndReturn(run func() ([]byte, error))
 	return _c
 }
 
+// GenerateTo provides a mock function with given fields: w
+func (_m *Provider) GenerateTo(w io.Writer) error {
+	ret := _m.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer) error); ok {
+		r0 = rf(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Provider_GenerateTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateTo'
+type Provider_GenerateTo_Call struct {
+	*mock.Call
+}
+
+// GenerateTo is a helper method to define mock.On call
+//   - w io.Writer
+func (_e *Provider_Expecter) GenerateTo(w interface{}) *Provider_GenerateTo_Call {
+	return &Provider_GenerateTo_Call{Call: _e.mock.On("GenerateTo", w)}
+}
+
+func (_c *Provider_GenerateTo_Call) Run(run func(w io.Writer)) *Provider_GenerateTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *Provider_GenerateTo_Call) Return(_a0 error) *Provider_GenerateTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GenerateTo_Call) RunAndReturn(run func(io.Writer) error) *Provider_GenerateTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDimensionsByImage provides a mock function with given fields: file
+func (_m *Provider) GetDimensionsByImage(file string) (*entity.Dimensions, error) {
+	ret := _m.Called(file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDimensionsByImage")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entity.Dimensions, error)); ok {
+		return rf(file)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entity.Dimensions); ok {
+		r0 = rf(file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetDimensionsByImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDimensionsByImage'
+type Provider_GetDimensionsByImage_Call struct {
+	*mock.Call
+}
+
+// GetDimensionsByImage is a helper method to define mock.On call
+//   - file string
+func (_e *Provider_Expecter) GetDimensionsByImage(file interface{}) *Provider_GetDimensionsByImage_Call {
+	return &Provider_GetDimensionsByImage_Call{Call: _e.mock.On("GetDimensionsByImage", file)}
+}
+
+func (_c *Provider_GetDimensionsByImage_Call) Run(run func(file string)) *Provider_GetDimensionsByImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByImage_Call) Return(_a0 *entity.Dimensions, _a1 error) *Provider_GetDimensionsByImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByImage_Call) RunAndReturn(run func(string) (*entity.Dimensions, error)) *Provider_GetDimensionsByImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDimensionsByImageByte provides a mock function with given fields: bytes, _a1
+func (_m *Provider) GetDimensionsByImageByte(bytes []byte, _a1 extension.Type) (*entity.Dimensions, error) {
+	ret := _m.Called(bytes, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDimensionsByImageByte")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte, extension.Type) (*entity.Dimensions, error)); ok {
+		return rf(bytes, _a1)
+	}
+	if rf, ok := ret.Get(0).(func([]byte, extension.Type) *entity.Dimensions); ok {
+		r0 = rf(bytes, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte, extension.Type) error); ok {
+		r1 = rf(bytes, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetDimensionsByImageByte_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDimensionsByImageByte'
+type Provider_GetDimensionsByImageByte_Call struct {
+	*mock.Call
+}
+
+// GetDimensionsByImageByte is a helper method to define mock.On call
+//   - bytes []byte
+//   - _a1 extension.Type
+func (_e *Provider_Expecter) GetDimensionsByImageByte(bytes interface{}, _a1 interface{}) *Provider_GetDimensionsByImageByte_Call {
+	return &Provider_GetDimensionsByImageByte_Call{Call: _e.mock.On("GetDimensionsByImageByte", bytes, _a1)}
+}
+
+func (_c *Provider_GetDimensionsByImageByte_Call) Run(run func(bytes []byte, _a1 extension.Type)) *Provider_GetDimensionsByImageByte_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(extension.Type))
+	})
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByImageByte_Call) Return(_a0 *entity.Dimensions, _a1 error) *Provider_GetDimensionsByImageByte_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByImageByte_Call) RunAndReturn(run func([]byte, extension.Type) (*entity.Dimensions, error)) *Provider_GetDimensionsByImageByte_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDimensionsByMatrixCode provides a mock function with given fields: code
+func (_m *Provider) GetDimensionsByMatrixCode(code string) (*entity.Dimensions, error) {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDimensionsByMatrixCode")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entity.Dimensions, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entity.Dimensions); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetDimensionsByMatrixCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDimensionsByMatrixCode'
+type Provider_GetDimensionsByMatrixCode_Call struct {
+	*mock.Call
+}
+
+// GetDimensionsByMatrixCode is a helper method to define mock.On call
+//   - code string
+func (_e *Provider_Expecter) GetDimensionsByMatrixCode(code interface{}) *Provider_GetDimensionsByMatrixCode_Call {
+	return &Provider_GetDimensionsByMatrixCode_Call{Call: _e.mock.On("GetDimensionsByMatrixCode", code)}
+}
+
+func (_c *Provider_GetDimensionsByMatrixCode_Call) Run(run func(code string)) *Provider_GetDimensionsByMatrixCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByMatrixCode_Call) Return(_a0 *entity.Dimensions, _a1 error) *Provider_GetDimensionsByMatrixCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByMatrixCode_Call) RunAndReturn(run func(string) (*entity.Dimensions, error)) *Provider_GetDimensionsByMatrixCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDimensionsByQrCode provides a mock function with given fields: code
+func (_m *Provider) GetDimensionsByQrCode(code string) (*entity.Dimensions, error) {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDimensionsByQrCode")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entity.Dimensions, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entity.Dimensions); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetDimensionsByQrCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDimensionsByQrCode'
+type Provider_GetDimensionsByQrCode_Call struct {
+	*mock.Call
+}
+
+// GetDimensionsByQrCode is a helper method to define mock.On call
+//   - code string
+func (_e *Provider_Expecter) GetDimensionsByQrCode(code interface{}) *Provider_GetDimensionsByQrCode_Call {
+	return &Provider_GetDimensionsByQrCode_Call{Call: _e.mock.On("GetDimensionsByQrCode", code)}
+}
+
+func (_c *Provider_GetDimensionsByQrCode_Call) Run(run func(code string)) *Provider_GetDimensionsByQrCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByQrCode_Call) Return(_a0 *entity.Dimensions, _a1 error) *Provider_GetDimensionsByQrCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByQrCode_Call) RunAndReturn(run func(string) (*entity.Dimensions, error)) *Provider_GetDimensionsByQrCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLines provides a mock function with given fields: text, textProp, colWidth
+func (_m *Provider) GetLines(text string, textProp *props.Text, colWidth float64) []string {
+	ret := _m.Called(text, textProp, colWidth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLines")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, *props.Text, float64) []string); ok {
+		r0 = rf(text, textProp, colWidth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// Provider_GetLines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLines'
+type Provider_GetLines_Call struct {
+	*mock.Call
+}
+
+// GetLines is a helper method to define mock.On call
+//   - text string
+//   - textProp *props.Text
+//   - colWidth float64
+func (_e *Provider_Expecter) GetLines(text interface{}, textProp interface{}, colWidth interface{}) *Provider_GetLines_Call {
+	return &Provider_GetLines_Call{Call: _e.mock.On("GetLines", text, textProp, colWidth)}
+}
+
+func (_c *Provider_GetLines_Call) Run(run func(text string, textProp *props.Text, colWidth float64)) *Provider_GetLines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Text), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Provider_GetLines_Call) Return(_a0 []string) *Provider_GetLines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GetLines_Call) RunAndReturn(run func(string, *props.Text, float64) []string) *Provider_GetLines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLinesQuantity provides a mock function with given fields: text, textProp, colWidth
+func (_m *Provider) GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int {
+	ret := _m.Called(text, textProp, colWidth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinesQuantity")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, *props.Text, float64) int); ok {
+		r0 = rf(text, textProp, colWidth)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Provider_GetLinesQuantity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinesQuantity'
+type Provider_GetLinesQuantity_Call struct {
+	*mock.Call
+}
+
+// GetLinesQuantity is a helper method to define mock.On call
+//   - text string
+//   - textProp *props.Text
+//   - colWidth float64
+func (_e *Provider_Expecter) GetLinesQuantity(text interface{}, textProp interface{}, colWidth interface{}) *Provider_GetLinesQuantity_Call {
+	return &Provider_GetLinesQuantity_Call{Call: _e.mock.On("GetLinesQuantity", text, textProp, colWidth)}
+}
+
+func (_c *Provider_GetLinesQuantity_Call) Run(run func(text string, textProp *props.Text, colWidth float64)) *Provider_GetLinesQuantity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Text), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Provider_GetLinesQuantity_Call) Return(_a0 int) *Provider_GetLinesQuantity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GetLinesQuantity_Call) RunAndReturn(run func(string, *props.Text, float64) int) *Provider_GetLinesQuantity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStringWidth provides a mock function with given fields: text, textProp
+func (_m *Provider) GetStringWidth(text string, textProp *props.Text) float64 {
+	ret := _m.Called(text, textProp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStringWidth")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, *props.Text) float64); ok {
+		r0 = rf(text, textProp)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Provider_GetStringWidth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStringWidth'
+type Provider_GetStringWidth_Call struct {
+	*mock.Call
+}
+
+// GetStringWidth is a helper method to define mock.On call
+//   - text string
+//   - textProp *props.Text
+func (_e *Provider_Expecter) GetStringWidth(text interface{}, textProp interface{}) *Provider_GetStringWidth_Call {
+	return &Provider_GetStringWidth_Call{Call: _e.mock.On("GetStringWidth", text, textProp)}
+}
+
+func (_c *Provider_GetStringWidth_Call) Run(run func(text string, textProp *props.Text)) *Provider_GetStringWidth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Text))
+	})
+	return _c
+}
+
+func (_c *Provider_GetStringWidth_Call) Return(_a0 float64) *Provider_GetStringWidth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GetStringWidth_Call) RunAndReturn(run func(string, *props.Text) float64) *Provider_GetStringWidth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTextHeight provides a mock function with given fields: prop
 func (_m *Provider) GetTextHeight(prop *props.Font) float64 {
 	ret := _m.Called(prop)
@@ -543,6 +1141,40 @@ func (_c *Provider_SetMetadata_Call) RunAndReturn(run func(*entity.Metadata)) *P
 	return _c
 }
 
+// SetPosition provides a mock function with given fields: x, y
+func (_m *Provider) SetPosition(x float64, y float64) {
+	_m.Called(x, y)
+}
+
+// Provider_SetPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPosition'
+type Provider_SetPosition_Call struct {
+	*mock.Call
+}
+
+// SetPosition is a helper method to define mock.On call
+//   - x float64
+//   - y float64
+func (_e *Provider_Expecter) SetPosition(x interface{}, y interface{}) *Provider_SetPosition_Call {
+	return &Provider_SetPosition_Call{Call: _e.mock.On("SetPosition", x, y)}
+}
+
+func (_c *Provider_SetPosition_Call) Run(run func(x float64, y float64)) *Provider_SetPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64))
+	})
+	return _c
+}
+
+func (_c *Provider_SetPosition_Call) Return() *Provider_SetPosition_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_SetPosition_Call) RunAndReturn(run func(float64, float64)) *Provider_SetPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetProtection provides a mock function with given fields: protection
 func (_m *Provider) SetProtection(protection *entity.Protection) {
 	_m.Called(protection)
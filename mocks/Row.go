@@ -10,6 +10,8 @@ import (
 
 	node "github.com/johnfercher/go-tree/node"
 
+	pagebreak "github.com/miguelbernadi/maroto/v2/pkg/consts/pagebreak"
+
 	props "github.com/miguelbernadi/maroto/v2/pkg/props"
 )
 
@@ -87,6 +89,145 @@ func (_c *Row_Add_Call) RunAndReturn(run func(...core.Col) core.Row) *Row_Add_Ca
 	return _c
 }
 
+// GetAnchor provides a mock function with given fields:
+func (_m *Row) GetAnchor() *entity.Anchor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnchor")
+	}
+
+	var r0 *entity.Anchor
+	if rf, ok := ret.Get(0).(func() *entity.Anchor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Anchor)
+		}
+	}
+
+	return r0
+}
+
+// Row_GetAnchor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnchor'
+type Row_GetAnchor_Call struct {
+	*mock.Call
+}
+
+// GetAnchor is a helper method to define mock.On call
+func (_e *Row_Expecter) GetAnchor() *Row_GetAnchor_Call {
+	return &Row_GetAnchor_Call{Call: _e.mock.On("GetAnchor")}
+}
+
+func (_c *Row_GetAnchor_Call) Run(run func()) *Row_GetAnchor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Row_GetAnchor_Call) Return(_a0 *entity.Anchor) *Row_GetAnchor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_GetAnchor_Call) RunAndReturn(run func() *entity.Anchor) *Row_GetAnchor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBookmark provides a mock function with given fields:
+func (_m *Row) GetBookmark() *entity.Bookmark {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBookmark")
+	}
+
+	var r0 *entity.Bookmark
+	if rf, ok := ret.Get(0).(func() *entity.Bookmark); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Bookmark)
+		}
+	}
+
+	return r0
+}
+
+// Row_GetBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBookmark'
+type Row_GetBookmark_Call struct {
+	*mock.Call
+}
+
+// GetBookmark is a helper method to define mock.On call
+func (_e *Row_Expecter) GetBookmark() *Row_GetBookmark_Call {
+	return &Row_GetBookmark_Call{Call: _e.mock.On("GetBookmark")}
+}
+
+func (_c *Row_GetBookmark_Call) Run(run func()) *Row_GetBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Row_GetBookmark_Call) Return(_a0 *entity.Bookmark) *Row_GetBookmark_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_GetBookmark_Call) RunAndReturn(run func() *entity.Bookmark) *Row_GetBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDestination provides a mock function with given fields:
+func (_m *Row) GetDestination() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDestination")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Row_GetDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDestination'
+type Row_GetDestination_Call struct {
+	*mock.Call
+}
+
+// GetDestination is a helper method to define mock.On call
+func (_e *Row_Expecter) GetDestination() *Row_GetDestination_Call {
+	return &Row_GetDestination_Call{Call: _e.mock.On("GetDestination")}
+}
+
+func (_c *Row_GetDestination_Call) Run(run func()) *Row_GetDestination_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Row_GetDestination_Call) Return(_a0 string) *Row_GetDestination_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_GetDestination_Call) RunAndReturn(run func() string) *Row_GetDestination_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetHeight provides a mock function with given fields:
 func (_m *Row) GetHeight() float64 {
 	ret := _m.Called()
@@ -132,6 +273,98 @@ func (_c *Row_GetHeight_Call) RunAndReturn(run func() float64) *Row_GetHeight_Ca
 	return _c
 }
 
+// GetPageBreakBefore provides a mock function with given fields:
+func (_m *Row) GetPageBreakBefore() pagebreak.Type {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPageBreakBefore")
+	}
+
+	var r0 pagebreak.Type
+	if rf, ok := ret.Get(0).(func() pagebreak.Type); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(pagebreak.Type)
+	}
+
+	return r0
+}
+
+// Row_GetPageBreakBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPageBreakBefore'
+type Row_GetPageBreakBefore_Call struct {
+	*mock.Call
+}
+
+// GetPageBreakBefore is a helper method to define mock.On call
+func (_e *Row_Expecter) GetPageBreakBefore() *Row_GetPageBreakBefore_Call {
+	return &Row_GetPageBreakBefore_Call{Call: _e.mock.On("GetPageBreakBefore")}
+}
+
+func (_c *Row_GetPageBreakBefore_Call) Run(run func()) *Row_GetPageBreakBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Row_GetPageBreakBefore_Call) Return(_a0 pagebreak.Type) *Row_GetPageBreakBefore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_GetPageBreakBefore_Call) RunAndReturn(run func() pagebreak.Type) *Row_GetPageBreakBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepeatedHeader provides a mock function with given fields:
+func (_m *Row) GetRepeatedHeader() []core.Row {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepeatedHeader")
+	}
+
+	var r0 []core.Row
+	if rf, ok := ret.Get(0).(func() []core.Row); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Row)
+		}
+	}
+
+	return r0
+}
+
+// Row_GetRepeatedHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepeatedHeader'
+type Row_GetRepeatedHeader_Call struct {
+	*mock.Call
+}
+
+// GetRepeatedHeader is a helper method to define mock.On call
+func (_e *Row_Expecter) GetRepeatedHeader() *Row_GetRepeatedHeader_Call {
+	return &Row_GetRepeatedHeader_Call{Call: _e.mock.On("GetRepeatedHeader")}
+}
+
+func (_c *Row_GetRepeatedHeader_Call) Run(run func()) *Row_GetRepeatedHeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Row_GetRepeatedHeader_Call) Return(_a0 []core.Row) *Row_GetRepeatedHeader_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_GetRepeatedHeader_Call) RunAndReturn(run func() []core.Row) *Row_GetRepeatedHeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStructure provides a mock function with given fields:
 func (_m *Row) GetStructure() *node.Node[core.Structure] {
 	ret := _m.Called()
@@ -179,6 +412,98 @@ func (_c *Row_GetStructure_Call) RunAndReturn(run func() *node.Node[core.Structu
 	return _c
 }
 
+// IsKeptWithNext provides a mock function with given fields:
+func (_m *Row) IsKeptWithNext() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsKeptWithNext")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Row_IsKeptWithNext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsKeptWithNext'
+type Row_IsKeptWithNext_Call struct {
+	*mock.Call
+}
+
+// IsKeptWithNext is a helper method to define mock.On call
+func (_e *Row_Expecter) IsKeptWithNext() *Row_IsKeptWithNext_Call {
+	return &Row_IsKeptWithNext_Call{Call: _e.mock.On("IsKeptWithNext")}
+}
+
+func (_c *Row_IsKeptWithNext_Call) Run(run func()) *Row_IsKeptWithNext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Row_IsKeptWithNext_Call) Return(_a0 bool) *Row_IsKeptWithNext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_IsKeptWithNext_Call) RunAndReturn(run func() bool) *Row_IsKeptWithNext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KeepWithNext provides a mock function with given fields:
+func (_m *Row) KeepWithNext() core.Row {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeepWithNext")
+	}
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func() core.Row); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// Row_KeepWithNext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeepWithNext'
+type Row_KeepWithNext_Call struct {
+	*mock.Call
+}
+
+// KeepWithNext is a helper method to define mock.On call
+func (_e *Row_Expecter) KeepWithNext() *Row_KeepWithNext_Call {
+	return &Row_KeepWithNext_Call{Call: _e.mock.On("KeepWithNext")}
+}
+
+func (_c *Row_KeepWithNext_Call) Run(run func()) *Row_KeepWithNext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Row_KeepWithNext_Call) Return(_a0 core.Row) *Row_KeepWithNext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_KeepWithNext_Call) RunAndReturn(run func() core.Row) *Row_KeepWithNext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Render provides a mock function with given fields: provider, cell
 func (_m *Row) Render(provider core.Provider, cell entity.Cell) {
 	_m.Called(provider, cell)
@@ -246,6 +571,336 @@ func (_c *Row_SetConfig_Call) RunAndReturn(run func(*entity.Config)) *Row_SetCon
 	return _c
 }
 
+// Split provides a mock function with given fields: provider, cell, height
+func (_m *Row) Split(provider core.Provider, cell *entity.Cell, height float64) (core.Row, core.Row) {
+	ret := _m.Called(provider, cell, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Split")
+	}
+
+	var r0 core.Row
+	var r1 core.Row
+	if rf, ok := ret.Get(0).(func(core.Provider, *entity.Cell, float64) (core.Row, core.Row)); ok {
+		return rf(provider, cell, height)
+	}
+	if rf, ok := ret.Get(0).(func(core.Provider, *entity.Cell, float64) core.Row); ok {
+		r0 = rf(provider, cell, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(core.Provider, *entity.Cell, float64) core.Row); ok {
+		r1 = rf(provider, cell, height)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(core.Row)
+		}
+	}
+
+	return r0, r1
+}
+
+// Row_Split_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Split'
+type Row_Split_Call struct {
+	*mock.Call
+}
+
+// Split is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell *entity.Cell
+//   - height float64
+func (_e *Row_Expecter) Split(provider interface{}, cell interface{}, height interface{}) *Row_Split_Call {
+	return &Row_Split_Call{Call: _e.mock.On("Split", provider, cell, height)}
+}
+
+func (_c *Row_Split_Call) Run(run func(provider core.Provider, cell *entity.Cell, height float64)) *Row_Split_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(*entity.Cell), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Row_Split_Call) Return(_a0 core.Row, _a1 core.Row) *Row_Split_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Row_Split_Call) RunAndReturn(run func(core.Provider, *entity.Cell, float64) (core.Row, core.Row)) *Row_Split_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithAnchor provides a mock function with given fields: title, level
+func (_m *Row) WithAnchor(title string, level int) core.Row {
+	ret := _m.Called(title, level)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithAnchor")
+	}
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(string, int) core.Row); ok {
+		r0 = rf(title, level)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// Row_WithAnchor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithAnchor'
+type Row_WithAnchor_Call struct {
+	*mock.Call
+}
+
+// WithAnchor is a helper method to define mock.On call
+//   - title string
+//   - level int
+func (_e *Row_Expecter) WithAnchor(title interface{}, level interface{}) *Row_WithAnchor_Call {
+	return &Row_WithAnchor_Call{Call: _e.mock.On("WithAnchor", title, level)}
+}
+
+func (_c *Row_WithAnchor_Call) Run(run func(title string, level int)) *Row_WithAnchor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Row_WithAnchor_Call) Return(_a0 core.Row) *Row_WithAnchor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_WithAnchor_Call) RunAndReturn(run func(string, int) core.Row) *Row_WithAnchor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithBookmark provides a mock function with given fields: title, level
+func (_m *Row) WithBookmark(title string, level int) core.Row {
+	ret := _m.Called(title, level)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithBookmark")
+	}
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(string, int) core.Row); ok {
+		r0 = rf(title, level)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// Row_WithBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithBookmark'
+type Row_WithBookmark_Call struct {
+	*mock.Call
+}
+
+// WithBookmark is a helper method to define mock.On call
+//   - title string
+//   - level int
+func (_e *Row_Expecter) WithBookmark(title interface{}, level interface{}) *Row_WithBookmark_Call {
+	return &Row_WithBookmark_Call{Call: _e.mock.On("WithBookmark", title, level)}
+}
+
+func (_c *Row_WithBookmark_Call) Run(run func(title string, level int)) *Row_WithBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Row_WithBookmark_Call) Return(_a0 core.Row) *Row_WithBookmark_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_WithBookmark_Call) RunAndReturn(run func(string, int) core.Row) *Row_WithBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithDestination provides a mock function with given fields: name
+func (_m *Row) WithDestination(name string) core.Row {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithDestination")
+	}
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(string) core.Row); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// Row_WithDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDestination'
+type Row_WithDestination_Call struct {
+	*mock.Call
+}
+
+// WithDestination is a helper method to define mock.On call
+//   - name string
+func (_e *Row_Expecter) WithDestination(name interface{}) *Row_WithDestination_Call {
+	return &Row_WithDestination_Call{Call: _e.mock.On("WithDestination", name)}
+}
+
+func (_c *Row_WithDestination_Call) Run(run func(name string)) *Row_WithDestination_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Row_WithDestination_Call) Return(_a0 core.Row) *Row_WithDestination_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_WithDestination_Call) RunAndReturn(run func(string) core.Row) *Row_WithDestination_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithPageBreakBefore provides a mock function with given fields: breakType
+func (_m *Row) WithPageBreakBefore(breakType ...pagebreak.Type) core.Row {
+	_va := make([]interface{}, len(breakType))
+	for _i := range breakType {
+		_va[_i] = breakType[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithPageBreakBefore")
+	}
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(...pagebreak.Type) core.Row); ok {
+		r0 = rf(breakType...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// Row_WithPageBreakBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithPageBreakBefore'
+type Row_WithPageBreakBefore_Call struct {
+	*mock.Call
+}
+
+// WithPageBreakBefore is a helper method to define mock.On call
+//   - breakType ...pagebreak.Type
+func (_e *Row_Expecter) WithPageBreakBefore(breakType ...interface{}) *Row_WithPageBreakBefore_Call {
+	return &Row_WithPageBreakBefore_Call{Call: _e.mock.On("WithPageBreakBefore",
+		append([]interface{}{}, breakType...)...)}
+}
+
+func (_c *Row_WithPageBreakBefore_Call) Run(run func(breakType ...pagebreak.Type)) *Row_WithPageBreakBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]pagebreak.Type, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(pagebreak.Type)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Row_WithPageBreakBefore_Call) Return(_a0 core.Row) *Row_WithPageBreakBefore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_WithPageBreakBefore_Call) RunAndReturn(run func(...pagebreak.Type) core.Row) *Row_WithPageBreakBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithRepeatedHeader provides a mock function with given fields: rows
+func (_m *Row) WithRepeatedHeader(rows ...core.Row) core.Row {
+	_va := make([]interface{}, len(rows))
+	for _i := range rows {
+		_va[_i] = rows[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithRepeatedHeader")
+	}
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(...core.Row) core.Row); ok {
+		r0 = rf(rows...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// Row_WithRepeatedHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithRepeatedHeader'
+type Row_WithRepeatedHeader_Call struct {
+	*mock.Call
+}
+
+// WithRepeatedHeader is a helper method to define mock.On call
+//   - rows ...core.Row
+func (_e *Row_Expecter) WithRepeatedHeader(rows ...interface{}) *Row_WithRepeatedHeader_Call {
+	return &Row_WithRepeatedHeader_Call{Call: _e.mock.On("WithRepeatedHeader",
+		append([]interface{}{}, rows...)...)}
+}
+
+func (_c *Row_WithRepeatedHeader_Call) Run(run func(rows ...core.Row)) *Row_WithRepeatedHeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Row, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Row)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Row_WithRepeatedHeader_Call) Return(_a0 core.Row) *Row_WithRepeatedHeader_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Row_WithRepeatedHeader_Call) RunAndReturn(run func(...core.Row) core.Row) *Row_WithRepeatedHeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithStyle provides a mock function with given fields: style
 func (_m *Row) WithStyle(style *props.Cell) core.Row {
 	ret := _m.Called(style)
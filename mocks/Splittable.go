@@ -0,0 +1,100 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	core "github.com/miguelbernadi/maroto/v2/pkg/core"
+	entity "github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Splittable is an autogenerated mock type for the Splittable type
+type Splittable struct {
+	mock.Mock
+}
+
+type Splittable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Splittable) EXPECT() *Splittable_Expecter {
+	return &Splittable_Expecter{mock: &_m.Mock}
+}
+
+// Split provides a mock function with given fields: provider, cell, height
+func (_m *Splittable) Split(provider core.Provider, cell *entity.Cell, height float64) (core.Component, core.Component) {
+	ret := _m.Called(provider, cell, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Split")
+	}
+
+	var r0 core.Component
+	var r1 core.Component
+	if rf, ok := ret.Get(0).(func(core.Provider, *entity.Cell, float64) (core.Component, core.Component)); ok {
+		return rf(provider, cell, height)
+	}
+	if rf, ok := ret.Get(0).(func(core.Provider, *entity.Cell, float64) core.Component); ok {
+		r0 = rf(provider, cell, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(core.Provider, *entity.Cell, float64) core.Component); ok {
+		r1 = rf(provider, cell, height)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(core.Component)
+		}
+	}
+
+	return r0, r1
+}
+
+// Splittable_Split_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Split'
+type Splittable_Split_Call struct {
+	*mock.Call
+}
+
+// Split is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell *entity.Cell
+//   - height float64
+func (_e *Splittable_Expecter) Split(provider interface{}, cell interface{}, height interface{}) *Splittable_Split_Call {
+	return &Splittable_Split_Call{Call: _e.mock.On("Split", provider, cell, height)}
+}
+
+func (_c *Splittable_Split_Call) Run(run func(provider core.Provider, cell *entity.Cell, height float64)) *Splittable_Split_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(*entity.Cell), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Splittable_Split_Call) Return(_a0 core.Component, _a1 core.Component) *Splittable_Split_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Splittable_Split_Call) RunAndReturn(run func(core.Provider, *entity.Cell, float64) (core.Component, core.Component)) *Splittable_Split_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSplittable creates a new instance of Splittable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSplittable(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *Splittable {
+	mock := &Splittable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,86 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	core "github.com/miguelbernadi/maroto/v2/pkg/core"
+	entity "github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TOC is an autogenerated mock type for the TOC type
+type TOC struct {
+	mock.Mock
+}
+
+type TOC_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TOC) EXPECT() *TOC_Expecter {
+	return &TOC_Expecter{mock: &_m.Mock}
+}
+
+// GetRows provides a mock function with given fields: anchors
+func (_m *TOC) GetRows(anchors []entity.Anchor) []core.Row {
+	ret := _m.Called(anchors)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRows")
+	}
+
+	var r0 []core.Row
+	if rf, ok := ret.Get(0).(func([]entity.Anchor) []core.Row); ok {
+		r0 = rf(anchors)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Row)
+		}
+	}
+
+	return r0
+}
+
+// TOC_GetRows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRows'
+type TOC_GetRows_Call struct {
+	*mock.Call
+}
+
+// GetRows is a helper method to define mock.On call
+//   - anchors []entity.Anchor
+func (_e *TOC_Expecter) GetRows(anchors interface{}) *TOC_GetRows_Call {
+	return &TOC_GetRows_Call{Call: _e.mock.On("GetRows", anchors)}
+}
+
+func (_c *TOC_GetRows_Call) Run(run func(anchors []entity.Anchor)) *TOC_GetRows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Anchor))
+	})
+	return _c
+}
+
+func (_c *TOC_GetRows_Call) Return(_a0 []core.Row) *TOC_GetRows_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TOC_GetRows_Call) RunAndReturn(run func([]entity.Anchor) []core.Row) *TOC_GetRows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTOC creates a new instance of TOC. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTOC(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *TOC {
+	mock := &TOC{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
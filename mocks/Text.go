@@ -57,6 +57,56 @@ func (_c *Text_Add_Call) RunAndReturn(run func(string, *entity.Cell, *props.Text
 	return _c
 }
 
+// GetLines provides a mock function with given fields: text, textProp, colWidth
+func (_m *Text) GetLines(text string, textProp *props.Text, colWidth float64) []string {
+	ret := _m.Called(text, textProp, colWidth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLines")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, *props.Text, float64) []string); ok {
+		r0 = rf(text, textProp, colWidth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// Text_GetLines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLines'
+type Text_GetLines_Call struct {
+	*mock.Call
+}
+
+// GetLines is a helper method to define mock.On call
+//   - text string
+//   - textProp *props.Text
+//   - colWidth float64
+func (_e *Text_Expecter) GetLines(text interface{}, textProp interface{}, colWidth interface{}) *Text_GetLines_Call {
+	return &Text_GetLines_Call{Call: _e.mock.On("GetLines", text, textProp, colWidth)}
+}
+
+func (_c *Text_GetLines_Call) Run(run func(text string, textProp *props.Text, colWidth float64)) *Text_GetLines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Text), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Text_GetLines_Call) Return(_a0 []string) *Text_GetLines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Text_GetLines_Call) RunAndReturn(run func(string, *props.Text, float64) []string) *Text_GetLines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLinesQuantity provides a mock function with given fields: text, fontFamily, colWidth
 func (_m *Text) GetLinesQuantity(text string, fontFamily props.Text, colWidth float64) int {
 	ret := _m.Called(text, fontFamily, colWidth)
@@ -105,6 +155,53 @@ func (_c *Text_GetLinesQuantity_Call) RunAndReturn(run func(string, props.Text,
 	return _c
 }
 
+// GetStringWidth provides a mock function with given fields: text, textProp
+func (_m *Text) GetStringWidth(text string, textProp *props.Text) float64 {
+	ret := _m.Called(text, textProp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStringWidth")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, *props.Text) float64); ok {
+		r0 = rf(text, textProp)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Text_GetStringWidth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStringWidth'
+type Text_GetStringWidth_Call struct {
+	*mock.Call
+}
+
+// GetStringWidth is a helper method to define mock.On call
+//   - text string
+//   - textProp *props.Text
+func (_e *Text_Expecter) GetStringWidth(text interface{}, textProp interface{}) *Text_GetStringWidth_Call {
+	return &Text_GetStringWidth_Call{Call: _e.mock.On("GetStringWidth", text, textProp)}
+}
+
+func (_c *Text_GetStringWidth_Call) Run(run func(text string, textProp *props.Text)) *Text_GetStringWidth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Text))
+	})
+	return _c
+}
+
+func (_c *Text_GetStringWidth_Call) Return(_a0 float64) *Text_GetStringWidth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Text_GetStringWidth_Call) RunAndReturn(run func(string, *props.Text) float64) *Text_GetStringWidth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewText creates a new instance of Text. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewText(t interface {
@@ -0,0 +1,83 @@
+package maroto
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+)
+
+// pageRows is a header or a footer built by a callback for one page. While the
+// document is paginated, it reserves the height of the rows built with what is
+// known of the page, and the rows are built again when the document is complete.
+type pageRows struct {
+	core.Row
+	height float64
+	build  func(ctx entity.PageContext) []core.Row
+	ctx    entity.PageContext
+	rows   []core.Row
+	config *entity.Config
+}
+
+func newPageRows(build func(ctx entity.PageContext) []core.Row, ctx entity.PageContext, height float64) *pageRows {
+	return &pageRows{
+		Row:    row.New(height),
+		height: height,
+		build:  build,
+		ctx:    ctx,
+	}
+}
+
+// SetConfig sets the configuration of the built rows.
+func (p *pageRows) SetConfig(config *entity.Config) {
+	p.config = config
+	for _, r := range p.rows {
+		r.SetConfig(config)
+	}
+}
+
+// setRows builds the rows with the number of the page, the total of pages and the section of the page.
+func (p *pageRows) setRows(number, total int, section entity.Section) {
+	p.ctx.Number = number
+	p.ctx.Total = total
+	p.ctx.Section = section.Name
+	p.ctx.Label = section.Label()
+	p.rows = p.build(p.ctx)
+
+	if p.config != nil {
+		p.SetConfig(p.config)
+	}
+}
+
+// Render renders the built rows, filling the reserved height when they are shorter.
+func (p *pageRows) Render(provider core.Provider, cell entity.Cell) {
+	height := p.height
+
+	for _, r := range p.rows {
+		r.Render(provider, cell)
+
+		rowHeight := core.GetRowHeight(r, provider, &cell)
+		cell.Y += rowHeight
+		height -= rowHeight
+	}
+
+	if height > 0 {
+		provider.CreateRow(height)
+	}
+}
+
+// GetStructure returns the Structure of the built rows.
+func (p *pageRows) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:  "page_rows",
+		Value: p.height,
+	}
+
+	n := node.New(str)
+	for _, r := range p.rows {
+		n.AddNext(r.GetStructure())
+	}
+
+	return n
+}
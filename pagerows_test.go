@@ -0,0 +1,58 @@
+package maroto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/internal/fixture"
+	"github.com/miguelbernadi/maroto/v2/mocks"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+)
+
+func TestPageRows_Render(t *testing.T) {
+	t.Run("when built rows are shorter than the reserved height, should fill the rest", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		cfg := &entity.Config{}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().CreateRow(4.0)
+		inner := &mocks.Row{}
+		inner.EXPECT().SetConfig(cfg)
+		inner.EXPECT().Render(provider, cell)
+		inner.EXPECT().GetHeight().Return(6.0)
+
+		var ctx entity.PageContext
+		sut := newPageRows(func(c entity.PageContext) []core.Row {
+			ctx = c
+			return []core.Row{inner}
+		}, entity.PageContext{FirstRow: 1, LastRow: 5}, 10)
+		sut.SetConfig(cfg)
+		sut.setRows(2, 3, entity.Section{Name: "intro", Numbering: numbering.LowerRoman, Number: 2, Total: 3})
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		assert.Equal(t, entity.PageContext{Number: 2, Total: 3, Section: "intro", Label: "ii", FirstRow: 1, LastRow: 5}, ctx)
+		inner.AssertNumberOfCalls(t, "Render", 1)
+		provider.AssertNumberOfCalls(t, "CreateRow", 1)
+	})
+}
+
+func TestPageRows_GetStructure(t *testing.T) {
+	// Arrange
+	sut := newPageRows(func(entity.PageContext) []core.Row {
+		return nil
+	}, entity.PageContext{}, 10)
+
+	// Act
+	structure := sut.GetStructure()
+
+	// Assert
+	assert.Equal(t, "page_rows", structure.GetData().Type)
+	assert.Equal(t, 10.0, structure.GetData().Value)
+}
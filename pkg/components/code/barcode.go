@@ -54,6 +54,13 @@ func NewBarRow(height float64, code string, ps ...props.Barcode) core.Row {
 	return row.New(height).Add(c)
 }
 
+// GetHeight returns the height that the Barcode will occupy in the percent of the cell
+// width, based on the barcode proportion.
+func (b *Barcode) GetHeight(_ core.Provider, cell *entity.Cell) float64 {
+	proportion := b.prop.Proportion.Height / b.prop.Proportion.Width
+	return proportion*cell.Width*b.prop.Percent/100 + b.prop.Top
+}
+
 // Render renders a Barcode into a PDF context. The maroto cal this methodo in process to
 // generate the pdf.
 //   - provider: Is the creator provider used to generate the pdf
@@ -4,6 +4,8 @@ package code_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/code"
@@ -61,6 +63,24 @@ func TestNewBarRow(t *testing.T) {
 	})
 }
 
+func TestBarcode_GetHeight(t *testing.T) {
+	t.Run("should return height based on barcode proportion", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.BarcodeProp()
+		sut := code.NewBar("code", prop)
+
+		provider := &mocks.Provider{}
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		proportion := prop.Proportion.Height / prop.Proportion.Width
+		assert.Equal(t, cell.Width*prop.Percent/100*proportion+prop.Top, height)
+	})
+}
+
 func TestBarcode_Render(t *testing.T) {
 	t.Run("should call provider correctly", func(t *testing.T) {
 		// Arrange
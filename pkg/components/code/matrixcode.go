@@ -5,6 +5,7 @@ package code
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/miguelbernadi/maroto/v2/internal/merror"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
@@ -45,6 +46,19 @@ func NewMatrixRow(height float64, code string, ps ...props.Rect) core.Row {
 	return row.New(height).Add(c)
 }
 
+// GetHeight returns the height that the MatrixCode will occupy in the percent of the cell width.
+// When the MatrixCode cannot be generated, it returns the height of the error text added in its
+// place, and in strict mode the failure is returned when the MatrixCode is rendered.
+func (m *MatrixCode) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	dimensions, err := provider.GetDimensionsByMatrixCode(m.code)
+	if err != nil {
+		return merror.GetHeight(provider)
+	}
+
+	proportion := dimensions.Height / dimensions.Width
+	return proportion*cell.Width*m.prop.Percent/100 + m.prop.Top
+}
+
 // Render renders a MatrixCode into a PDF context.
 func (m *MatrixCode) Render(provider core.Provider, cell *entity.Cell) {
 	provider.AddMatrixCode(m.code, cell, &m.prop)
@@ -2,11 +2,16 @@
 package code_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/code"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
 )
 
@@ -61,6 +66,39 @@ func TestNewMatrixRow(t *testing.T) {
 	})
 }
 
+func TestMatrixCode_GetHeight(t *testing.T) {
+	t.Run("when cannot generate matrixcode, should return height of error text", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := code.NewMatrix("code")
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetDimensionsByMatrixCode("code").Return(nil, errors.New("anyError"))
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 4.0, height)
+	})
+	t.Run("when can generate matrixcode, should return height proportional to cell width", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.RectProp()
+		sut := code.NewMatrix("code", prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetDimensionsByMatrixCode("code").Return(&entity.Dimensions{Width: 20, Height: 20}, nil)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, cell.Width*prop.Percent/100+prop.Top, height)
+	})
+}
+
 func TestMatrixCode_Render(t *testing.T) {
 	t.Run("should call provider correctly", func(t *testing.T) {
 		// Arrange
@@ -5,6 +5,7 @@ package code
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/miguelbernadi/maroto/v2/internal/merror"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
@@ -45,6 +46,19 @@ func NewQrRow(height float64, code string, ps ...props.Rect) core.Row {
 	return row.New(height).Add(c)
 }
 
+// GetHeight returns the height that the QrCode will occupy in the percent of the cell width.
+// When the QrCode cannot be generated, it returns the height of the error text added in its
+// place, and in strict mode the failure is returned when the QrCode is rendered.
+func (q *QrCode) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	dimensions, err := provider.GetDimensionsByQrCode(q.code)
+	if err != nil {
+		return merror.GetHeight(provider)
+	}
+
+	proportion := dimensions.Height / dimensions.Width
+	return proportion*cell.Width*q.prop.Percent/100 + q.prop.Top
+}
+
 // Render renders a QrCode into a PDF context.
 func (q *QrCode) Render(provider core.Provider, cell *entity.Cell) {
 	provider.AddQrCode(q.code, cell, &q.prop)
@@ -4,6 +4,7 @@ package col
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/miguelbernadi/maroto/v2/pkg/props"
@@ -13,8 +14,10 @@ type Col struct {
 	size       int
 	isMax      bool
 	components []core.Component
+	rows       []core.Row
 	config     *entity.Config
 	style      *props.Cell
+	stack      *props.Stack
 }
 
 // New is responsible to create an instance of core.Col.
@@ -32,6 +35,14 @@ func (c *Col) Add(components ...core.Component) core.Col {
 	return c
 }
 
+// AddRows is responsible to add rows to a core.Col, which are placed one under
+// another from the top of the column. The size of the cols of these rows is
+// relative to the width of the column.
+func (c *Col) AddRows(rows ...core.Row) core.Col {
+	c.rows = append(c.rows, rows...)
+	return c
+}
+
 // GetSize returns the size of a core.Col.
 func (c *Col) GetSize() int {
 	if c.isMax {
@@ -41,6 +52,32 @@ func (c *Col) GetSize() int {
 	return c.size
 }
 
+// GetHeight returns the height of the highest component of a core.Col,
+// or the height of all components together when the components are stacked.
+// When the core.Col has rows, the height is at least the height of the rows.
+// The padding of the core.Col is added to the height of the content.
+func (c *Col) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	innerCell := cell.ApplyPadding(c.style)
+	greaterHeight := c.getRowsHeight(provider, &innerCell)
+
+	if c.stack != nil {
+		_, stackHeight := c.getStackHeights(provider, &innerCell)
+		if stackHeight > greaterHeight {
+			greaterHeight = stackHeight
+		}
+		return greaterHeight + c.style.GetVerticalPadding()
+	}
+
+	for _, component := range c.components {
+		height := component.GetHeight(provider, &innerCell)
+		if height > greaterHeight {
+			greaterHeight = height
+		}
+	}
+
+	return greaterHeight + c.style.GetVerticalPadding()
+}
+
 // GetStructure returns the Structure of a core.Col.
 func (c *Col) GetStructure() *node.Node[core.Structure] {
 	str := core.Structure{
@@ -56,6 +93,16 @@ func (c *Col) GetStructure() *node.Node[core.Structure] {
 		str.Details["is_max"] = true
 	}
 
+	if c.stack != nil {
+		if len(str.Details) == 0 {
+			str.Details = make(map[string]interface{})
+		}
+		for key, value := range c.stack.ToMap() {
+			str.Details[key] = value
+		}
+		str.Details["is_stack"] = true
+	}
+
 	node := node.New(str)
 
 	for _, c := range c.components {
@@ -63,17 +110,33 @@ func (c *Col) GetStructure() *node.Node[core.Structure] {
 		node.AddNext(inner)
 	}
 
+	for _, r := range c.rows {
+		inner := r.GetStructure()
+		node.AddNext(inner)
+	}
+
 	return node
 }
 
-// Render renders a core.Col into a PDF context.
+// Render renders a core.Col into a PDF context. The components
+// are rendered inside the padding of the core.Col.
 func (c *Col) Render(provider core.Provider, cell entity.Cell, createCell bool) {
 	if createCell {
 		provider.CreateCol(cell.Width, cell.Height, c.config, c.style)
 	}
 
-	for _, component := range c.components {
-		component.Render(provider, &cell)
+	innerCell := cell.ApplyPadding(c.style)
+	if c.stack != nil {
+		c.renderStack(provider, innerCell)
+	} else {
+		for _, component := range c.components {
+			component.Render(provider, &innerCell)
+		}
+	}
+
+	if len(c.rows) > 0 {
+		c.renderRows(provider, innerCell)
+		provider.SetPosition(cell.X+cell.Width, cell.Y)
 	}
 }
 
@@ -83,6 +146,10 @@ func (c *Col) SetConfig(config *entity.Config) {
 	for _, component := range c.components {
 		component.SetConfig(config)
 	}
+
+	for _, row := range c.rows {
+		row.SetConfig(config)
+	}
 }
 
 // WithStyle sets the style for the column.
@@ -90,3 +157,116 @@ func (c *Col) WithStyle(style *props.Cell) core.Col {
 	c.style = style
 	return c
 }
+
+// WithStack stacks the components of the column vertically, where each component
+// is rendered in its own cell with the height of the component. A nil stack uses
+// the default properties. The stack is copied, so the caller's value is not changed.
+func (c *Col) WithStack(stack *props.Stack) core.Col {
+	c.stack = &props.Stack{}
+	if stack != nil {
+		*c.stack = *stack
+	}
+
+	c.stack.MakeValid()
+	return c
+}
+
+// Split splits the components of a core.Col in two cols with the same size and
+// style, where the first col has the content which fits in the height and the
+// second col has the rest of the content. It returns false when nothing is
+// split. Only components which implement core.Splittable, e.g. texts, are split,
+// other components, stacked components and rows are kept in the first col.
+func (c *Col) Split(provider core.Provider, cell *entity.Cell, height float64) (core.Col, core.Col, bool) {
+	first := &Col{size: c.size, isMax: c.isMax, config: c.config, style: c.style}
+	rest := &Col{size: c.size, isMax: c.isMax, config: c.config, style: c.style}
+
+	if c.stack != nil || len(c.rows) > 0 {
+		return c, rest, false
+	}
+
+	innerCell := cell.ApplyPadding(c.style)
+	contentHeight := height - c.style.GetVerticalPadding()
+	split := false
+
+	for _, component := range c.components {
+		splittable, ok := component.(core.Splittable)
+		if !ok || component.GetHeight(provider, &innerCell) <= contentHeight {
+			first.components = append(first.components, component)
+			continue
+		}
+
+		firstComponent, restComponent := splittable.Split(provider, &innerCell, contentHeight)
+		if firstComponent != nil {
+			first.components = append(first.components, firstComponent)
+		}
+
+		if restComponent != nil {
+			rest.components = append(rest.components, restComponent)
+			split = true
+		}
+	}
+
+	return first, rest, split
+}
+
+func (c *Col) renderStack(provider core.Provider, cell entity.Cell) {
+	heights, stackHeight := c.getStackHeights(provider, &cell)
+
+	y := cell.Y
+	space := cell.Height - stackHeight
+	if space > 0 && c.stack.VerticalAlign == align.Middle {
+		y += space / 2
+	}
+
+	if space > 0 && c.stack.VerticalAlign == align.Bottom {
+		y += space
+	}
+
+	for i, component := range c.components {
+		innerCell := cell
+		innerCell.Y = y
+		innerCell.Height = heights[i]
+
+		component.Render(provider, &innerCell)
+		y += heights[i] + c.stack.Spacing
+	}
+}
+
+func (c *Col) getStackHeights(provider core.Provider, cell *entity.Cell) ([]float64, float64) {
+	heights := make([]float64, len(c.components))
+	stackHeight := 0.0
+
+	for i, component := range c.components {
+		heights[i] = component.GetHeight(provider, cell)
+		stackHeight += heights[i]
+	}
+
+	if len(c.components) > 1 {
+		stackHeight += c.stack.Spacing * float64(len(c.components)-1)
+	}
+
+	return heights, stackHeight
+}
+
+// renderRows renders the rows from the top of the cell. Then the position
+// of the provider must be moved back to the end of the column, where the
+// next column of the parent row starts.
+func (c *Col) renderRows(provider core.Provider, cell entity.Cell) {
+	innerCell := cell.Copy()
+	for _, row := range c.rows {
+		innerCell.Height = core.GetRowHeight(row, provider, &innerCell)
+
+		provider.SetPosition(innerCell.X, innerCell.Y)
+		row.Render(provider, innerCell)
+		innerCell.Y += innerCell.Height
+	}
+}
+
+func (c *Col) getRowsHeight(provider core.Provider, cell *entity.Cell) float64 {
+	rowsHeight := 0.0
+	for _, row := range c.rows {
+		rowsHeight += core.GetRowHeight(row, provider, cell)
+	}
+
+	return rowsHeight
+}
@@ -1,14 +1,19 @@
 package col_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/code"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/text"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/miguelbernadi/maroto/v2/pkg/props"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
@@ -44,6 +49,41 @@ func TestNew(t *testing.T) {
 		// Assert
 		test.New(t).Assert(c.GetStructure()).Equals("components/cols/new_with_props.json")
 	})
+	t.Run("when has stack, should retrieve stack", func(t *testing.T) {
+		// Act
+		c := col.New(12).WithStack(&props.Stack{Spacing: 2, VerticalAlign: align.Middle}).Add(code.NewQr("code"))
+
+		// Assert
+		test.New(t).Assert(c.GetStructure()).Equals("components/cols/new_with_stack.json")
+	})
+	t.Run("when stack is nil, should stack with default props", func(t *testing.T) {
+		// Act
+		c := col.New(12).WithStack(nil).Add(code.NewQr("code"))
+
+		// Assert
+		assert.Equal(t, true, c.GetStructure().GetData().Details["is_stack"])
+	})
+	t.Run("when stack is invalid, should not change the stack of the caller", func(t *testing.T) {
+		// Arrange
+		stack := &props.Stack{Spacing: -1, VerticalAlign: "invalid"}
+
+		// Act
+		_ = col.New(12).WithStack(stack)
+
+		// Assert
+		assert.Equal(t, -1.0, stack.Spacing)
+		assert.Equal(t, align.Type("invalid"), stack.VerticalAlign)
+	})
+	t.Run("when has rows, should retrieve rows", func(t *testing.T) {
+		// Act
+		c := col.New(12).AddRows(
+			row.New(10).Add(col.New(4).Add(text.New("label")), col.New(8).Add(text.New("value"))),
+			row.New(10).Add(col.New(12)),
+		)
+
+		// Assert
+		test.New(t).Assert(c.GetStructure()).Equals("components/cols/new_with_rows.json")
+	})
 }
 
 func TestCol_GetSize(t *testing.T) {
@@ -70,6 +110,157 @@ func TestCol_GetSize(t *testing.T) {
 	})
 }
 
+func TestCol_GetHeight(t *testing.T) {
+	t.Run("when there is no components, should return zero", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		sut := col.New(12)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 0.0, height)
+	})
+	t.Run("when there is components, should return the greater component height", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		smaller := &mocks.Component{}
+		smaller.EXPECT().GetHeight(provider, &cell).Return(10.0)
+
+		greater := &mocks.Component{}
+		greater.EXPECT().GetHeight(provider, &cell).Return(20.0)
+
+		sut := col.New(12).Add(smaller, greater)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 20.0, height)
+		smaller.AssertNumberOfCalls(t, "GetHeight", 1)
+		greater.AssertNumberOfCalls(t, "GetHeight", 1)
+	})
+	t.Run("when components are stacked, should return the sum of heights and spacing", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		first := &mocks.Component{}
+		first.EXPECT().GetHeight(provider, &cell).Return(10.0)
+
+		second := &mocks.Component{}
+		second.EXPECT().GetHeight(provider, &cell).Return(20.0)
+
+		sut := col.New(12).WithStack(&props.Stack{Spacing: 2}).Add(first, second)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 32.0, height)
+	})
+	t.Run("when there is padding, should measure components inside the padding", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 50, Height: 100}
+		provider := &mocks.Provider{}
+
+		component := &mocks.Component{}
+		component.EXPECT().GetHeight(provider, &entity.Cell{X: 1, Y: 2, Width: 46, Height: 94}).Return(10.0)
+
+		style := &props.Cell{PaddingLeft: 1, PaddingTop: 2, PaddingRight: 3, PaddingBottom: 4}
+		sut := col.New(12).Add(component).WithStyle(style)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 16.0, height)
+	})
+	t.Run("when there are rows higher than components, should return the sum of the rows heights", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		component := &mocks.Component{}
+		component.EXPECT().GetHeight(provider, &cell).Return(10.0)
+
+		first := &mocks.Row{}
+		first.EXPECT().GetHeight().Return(8.0)
+
+		second := &mocks.Row{}
+		second.EXPECT().GetHeight().Return(12.0)
+
+		sut := col.New(12).Add(component).AddRows(first, second)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 20.0, height)
+	})
+}
+
+func TestCol_Split(t *testing.T) {
+	t.Run("when components fit in height, should not split", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		component := &mocks.Component{}
+		component.EXPECT().GetHeight(provider, &cell).Return(10.0)
+
+		sut := col.New(12).Add(component)
+
+		// Act
+		first, rest, split := sut.Split(provider, &cell, 20)
+
+		// Assert
+		assert.False(t, split)
+		assert.Equal(t, col.New(12).Add(component), first)
+		assert.Empty(t, rest.GetStructure().GetNexts())
+	})
+	t.Run("when text does not fit in height, should move the remaining lines to rest", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(5.0)
+		provider.EXPECT().GetLinesQuantity(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(value string, _ *props.Text, _ float64) int {
+				return len(strings.Fields(value))
+			})
+
+		sut := col.New(12).Add(text.New("a b c"))
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{}})
+
+		// Act
+		first, rest, split := sut.Split(provider, &cell, 10)
+
+		// Assert
+		assert.True(t, split)
+		assert.Equal(t, "a b", first.GetStructure().GetNexts()[0].GetData().Value)
+		assert.Equal(t, "c", rest.GetStructure().GetNexts()[0].GetData().Value)
+	})
+	t.Run("when components are stacked, should not split", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		sut := col.New(12).Add(text.New("a b c")).WithStack(&props.Stack{})
+
+		// Act
+		first, _, split := sut.Split(provider, &cell, 10)
+
+		// Assert
+		assert.False(t, split)
+		assert.Equal(t, sut, first)
+	})
+}
+
 func TestCol_Render(t *testing.T) {
 	t.Run("when not createCell, should call provider correctly", func(t *testing.T) {
 		// Arrange
@@ -119,4 +310,123 @@ func TestCol_Render(t *testing.T) {
 		component.AssertNumberOfCalls(t, "Render", 1)
 		component.AssertNumberOfCalls(t, "SetConfig", 1)
 	})
+	t.Run("when components are stacked, should render each component in its own cell", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := entity.Cell{X: 10, Y: 20, Width: 50, Height: 100}
+		provider := &mocks.Provider{}
+
+		first := &mocks.Component{}
+		first.EXPECT().GetHeight(provider, &cell).Return(10.0)
+		first.EXPECT().SetConfig(cfg)
+		first.EXPECT().Render(provider, &entity.Cell{X: 10, Y: 20, Width: 50, Height: 10})
+
+		second := &mocks.Component{}
+		second.EXPECT().GetHeight(provider, &cell).Return(20.0)
+		second.EXPECT().SetConfig(cfg)
+		second.EXPECT().Render(provider, &entity.Cell{X: 10, Y: 32, Width: 50, Height: 20})
+
+		sut := col.New(12).WithStack(&props.Stack{Spacing: 2}).Add(first, second)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, false)
+
+		// Assert
+		first.AssertNumberOfCalls(t, "Render", 1)
+		second.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when stack is aligned in the middle, should center the components", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := entity.Cell{X: 10, Y: 20, Width: 50, Height: 100}
+		provider := &mocks.Provider{}
+
+		component := &mocks.Component{}
+		component.EXPECT().GetHeight(provider, &cell).Return(40.0)
+		component.EXPECT().SetConfig(cfg)
+		component.EXPECT().Render(provider, &entity.Cell{X: 10, Y: 50, Width: 50, Height: 40})
+
+		sut := col.New(12).WithStack(&props.Stack{VerticalAlign: align.Middle}).Add(component)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, false)
+
+		// Assert
+		component.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when stack is aligned in the bottom, should place the components at the end of the cell", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := entity.Cell{X: 10, Y: 20, Width: 50, Height: 100}
+		provider := &mocks.Provider{}
+
+		component := &mocks.Component{}
+		component.EXPECT().GetHeight(provider, &cell).Return(40.0)
+		component.EXPECT().SetConfig(cfg)
+		component.EXPECT().Render(provider, &entity.Cell{X: 10, Y: 80, Width: 50, Height: 40})
+
+		sut := col.New(12).WithStack(&props.Stack{VerticalAlign: align.Bottom}).Add(component)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, false)
+
+		// Assert
+		component.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when there are rows, should render the rows one under another", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := entity.Cell{X: 10, Y: 20, Width: 50, Height: 100}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().SetPosition(10.0, 20.0)
+		provider.EXPECT().SetPosition(10.0, 30.0)
+		provider.EXPECT().SetPosition(60.0, 20.0)
+
+		first := &mocks.Row{}
+		first.EXPECT().SetConfig(cfg)
+		first.EXPECT().GetHeight().Return(10.0)
+		first.EXPECT().Render(provider, entity.Cell{X: 10, Y: 20, Width: 50, Height: 10})
+
+		second := &mocks.Row{}
+		second.EXPECT().SetConfig(cfg)
+		second.EXPECT().GetHeight().Return(15.0)
+		second.EXPECT().Render(provider, entity.Cell{X: 10, Y: 30, Width: 50, Height: 15})
+
+		sut := col.New(12).AddRows(first, second)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, false)
+
+		// Assert
+		first.AssertNumberOfCalls(t, "Render", 1)
+		second.AssertNumberOfCalls(t, "Render", 1)
+		provider.AssertNumberOfCalls(t, "SetPosition", 3)
+	})
+	t.Run("when there is padding, should render components inside the padding", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := entity.Cell{X: 10, Y: 20, Width: 50, Height: 100}
+		style := &props.Cell{PaddingLeft: 1, PaddingTop: 2, PaddingRight: 3, PaddingBottom: 4}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().CreateCol(50.0, 100.0, cfg, style)
+
+		component := &mocks.Component{}
+		component.EXPECT().SetConfig(cfg)
+		component.EXPECT().Render(provider, &entity.Cell{X: 11, Y: 22, Width: 46, Height: 94})
+
+		sut := col.New(12).Add(component).WithStyle(style)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, true)
+
+		// Assert
+		component.AssertNumberOfCalls(t, "Render", 1)
+	})
 }
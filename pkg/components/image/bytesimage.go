@@ -4,6 +4,7 @@ package image
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/miguelbernadi/maroto/v2/internal/merror"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
@@ -47,6 +48,19 @@ func NewFromBytesRow(height float64, bytes []byte, extension extension.Type, ps
 	return row.New(height).Add(c)
 }
 
+// GetHeight returns the height that the image will occupy in the percent of the cell width.
+// When the image cannot be loaded, it returns the height of the error text added in its
+// place, and in strict mode the failure is returned when the image is rendered.
+func (b *BytesImage) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	dimensions, err := provider.GetDimensionsByImageByte(b.bytes, b.extension)
+	if err != nil {
+		return merror.GetHeight(provider)
+	}
+
+	proportion := dimensions.Height / dimensions.Width
+	return proportion*cell.Width*b.prop.Percent/100 + b.prop.Top
+}
+
 // Render renders an Image into a PDF context.
 func (b *BytesImage) Render(provider core.Provider, cell *entity.Cell) {
 	provider.AddImageFromBytes(b.bytes, cell, &b.prop, b.extension)
@@ -1,12 +1,17 @@
 package image_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/image"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
 )
 
@@ -61,6 +66,43 @@ func TestNewFromBytesRow(t *testing.T) {
 	})
 }
 
+func TestBytesImage_GetHeight(t *testing.T) {
+	t.Run("when cannot get image dimensions, should return height of error text", func(t *testing.T) {
+		// Arrange
+		bytes := []byte{1, 2, 3}
+		ext := extension.Jpg
+		cell := fixture.CellEntity()
+		sut := image.NewFromBytes(bytes, ext)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetDimensionsByImageByte(bytes, ext).Return(nil, errors.New("anyError"))
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 4.0, height)
+	})
+	t.Run("when can get image dimensions, should return height proportional to cell width", func(t *testing.T) {
+		// Arrange
+		bytes := []byte{1, 2, 3}
+		ext := extension.Jpg
+		cell := fixture.CellEntity()
+		prop := fixture.RectProp()
+		sut := image.NewFromBytes(bytes, ext, prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetDimensionsByImageByte(bytes, ext).Return(&entity.Dimensions{Width: 200, Height: 100}, nil)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, cell.Width*prop.Percent/100/2+prop.Top, height)
+	})
+}
+
 func TestBytesImage_Render(t *testing.T) {
 	t.Run("should call provider correctly", func(t *testing.T) {
 		// Arrange
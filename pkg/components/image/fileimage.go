@@ -4,6 +4,7 @@ package image
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/miguelbernadi/maroto/v2/internal/merror"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
@@ -44,6 +45,19 @@ func NewFromFileRow(height float64, path string, ps ...props.Rect) core.Row {
 	return row.New(height).Add(c)
 }
 
+// GetHeight returns the height that the image will occupy in the percent of the cell width.
+// When the image cannot be loaded, it returns the height of the error text added in its
+// place, and in strict mode the failure is returned when the image is rendered.
+func (f *FileImage) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	dimensions, err := provider.GetDimensionsByImage(f.path)
+	if err != nil {
+		return merror.GetHeight(provider)
+	}
+
+	proportion := dimensions.Height / dimensions.Width
+	return proportion*cell.Width*f.prop.Percent/100 + f.prop.Top
+}
+
 // Render renders an Image into a PDF context.
 func (f *FileImage) Render(provider core.Provider, cell *entity.Cell) {
 	provider.AddImageFromFile(f.path, cell, &f.prop)
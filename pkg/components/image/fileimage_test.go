@@ -1,10 +1,15 @@
 package image_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/mocks"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
 
 	"github.com/miguelbernadi/maroto/v2/pkg/components/image"
@@ -61,6 +66,41 @@ func TestNewFromFileRow(t *testing.T) {
 	})
 }
 
+func TestFileImage_GetHeight(t *testing.T) {
+	t.Run("when cannot get image dimensions, should return height of error text", func(t *testing.T) {
+		// Arrange
+		path := "path"
+		cell := fixture.CellEntity()
+		sut := image.NewFromFile(path)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetDimensionsByImage(path).Return(nil, errors.New("anyError"))
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 4.0, height)
+	})
+	t.Run("when can get image dimensions, should return height proportional to cell width", func(t *testing.T) {
+		// Arrange
+		path := "path"
+		cell := fixture.CellEntity()
+		prop := fixture.RectProp()
+		sut := image.NewFromFile(path, prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetDimensionsByImage(path).Return(&entity.Dimensions{Width: 100, Height: 150}, nil)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, cell.Width*prop.Percent/100*1.5+prop.Top, height)
+	})
+}
+
 func TestFileImage_Render(t *testing.T) {
 	t.Run("should call provider correctly", func(t *testing.T) {
 		// Arrange
@@ -57,6 +57,11 @@ func (l *Line) SetConfig(config *entity.Config) {
 	l.config = config
 }
 
+// GetHeight returns the height of a Line, which is its thickness.
+func (l *Line) GetHeight(_ core.Provider, _ *entity.Cell) float64 {
+	return l.prop.Thickness
+}
+
 // Render renders a Line into a PDF context.
 func (l *Line) Render(provider core.Provider, cell *entity.Cell) {
 	provider.AddLine(cell, &l.prop)
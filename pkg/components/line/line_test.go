@@ -3,6 +3,8 @@ package line_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
@@ -61,6 +63,21 @@ func TestNewRow(t *testing.T) {
 	})
 }
 
+func TestLine_GetHeight(t *testing.T) {
+	t.Run("should return the line thickness", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.LineProp()
+		sut := line.New(prop)
+
+		// Act
+		height := sut.GetHeight(&mocks.Provider{}, &cell)
+
+		// Assert
+		assert.Equal(t, prop.Thickness, height)
+	})
+}
+
 func TestLine_Render(t *testing.T) {
 	t.Run("should call provider correctly", func(t *testing.T) {
 		// Arrange
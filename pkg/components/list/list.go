@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
 )
 
 // Listable is the main abstraction of a listable item in a TableList.
@@ -18,7 +19,7 @@ type Listable interface {
 // BuildFromPointer is responsible to receive a collection of objects that implements
 // Listable and build the rows of TableList. This method should be used in case of a collection
 // of pointers.
-func BuildFromPointer[T Listable](arr []*T) ([]core.Row, error) {
+func BuildFromPointer[T Listable](arr []*T, ps ...props.List) ([]core.Row, error) {
 	if len(arr) == 0 {
 		return nil, errors.New("empty array")
 	}
@@ -31,13 +32,14 @@ func BuildFromPointer[T Listable](arr []*T) ([]core.Row, error) {
 		list = append(list, *pointer)
 	}
 
-	return Build(list)
+	return Build(list, ps...)
 }
 
 // Build is responsible to receive a collection of objects that implements
 // Listable and build the rows of TableList. This method should be used in case of a collection
-// of values.
-func Build[T Listable](arr []T) ([]core.Row, error) {
+// of values. The props.List define the minimum quantity of rows kept together with the header
+// and at the end of the list, when the list is split in pages.
+func Build[T Listable](arr []T, ps ...props.List) ([]core.Row, error) {
 	if len(arr) == 0 {
 		return nil, errors.New("empty array")
 	}
@@ -50,5 +52,30 @@ func Build[T Listable](arr []T) ([]core.Row, error) {
 		rows = append(rows, element.GetContent(i))
 	}
 
+	if len(ps) > 0 {
+		prop := ps[0]
+		prop.MakeValid()
+		keepTogether(rows, prop)
+	}
+
 	return rows, nil
 }
+
+// keepTogether keeps the header with the first rows of the list,
+// and the last rows of the list in the same page.
+func keepTogether(rows []core.Row, prop props.List) {
+	contentRows := len(rows) - 1
+
+	for i := 0; i < prop.MinRowsBefore && i < contentRows; i++ {
+		rows[i].KeepWithNext()
+	}
+
+	start := len(rows) - prop.MinRowsAfter
+	if start < 1 {
+		start = 1
+	}
+
+	for i := start; i < len(rows)-1; i++ {
+		rows[i].KeepWithNext()
+	}
+}
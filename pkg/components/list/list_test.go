@@ -4,14 +4,16 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/list"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/page"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/text"
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
-	"github.com/stretchr/testify/assert"
 )
 
 type anyType struct {
@@ -63,6 +65,31 @@ func TestBuild(t *testing.T) {
 		assert.Nil(t, err)
 		test.New(t).Assert(p.GetStructure()).Equals("components/list/build.json")
 	})
+	t.Run("when there are min rows, should keep the header and the last rows together", func(t *testing.T) {
+		// Arrange
+		arr := buildList(6)
+
+		// Act
+		r, err := list.Build(arr, props.List{MinRowsBefore: 2, MinRowsAfter: 3})
+		p := page.New().Add(r...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/list/build_with_min_rows.json")
+	})
+	t.Run("when min rows are greater than the list, should not keep the last row with next", func(t *testing.T) {
+		// Arrange
+		arr := buildList(2)
+
+		// Act
+		r, err := list.Build(arr, props.List{MinRowsBefore: 5, MinRowsAfter: 5})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.True(t, r[0].IsKeptWithNext())
+		assert.True(t, r[1].IsKeptWithNext())
+		assert.False(t, r[2].IsKeptWithNext())
+	})
 }
 
 func TestBuildFromPointer(t *testing.T) {
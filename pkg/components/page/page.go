@@ -10,11 +10,12 @@ import (
 )
 
 type Page struct {
-	number int
-	total  int
-	rows   []core.Row
-	config *entity.Config
-	prop   props.Page
+	number  int
+	total   int
+	section entity.Section
+	rows    []core.Row
+	config  *entity.Config
+	prop    props.Page
 }
 
 // New is responsible to create a core.Page.
@@ -29,9 +30,17 @@ func New(ps ...props.Page) core.Page {
 	}
 }
 
-// Render renders a Page into a PDF context.
+// Render renders a Page into a PDF context. The Page starts a new page in the
+// provider, with its own size and orientation when they are defined.
 func (p *Page) Render(provider core.Provider, cell entity.Cell) {
+	width, height := p.prop.GetDimensions(p.config.Dimensions.Width, p.config.Dimensions.Height)
+	provider.AddPage(width, height)
+
 	innerCell := cell.Copy()
+	innerCell.Path.Page = p.number
+	innerCell.Page = &entity.PageNumber{Number: p.number, Total: p.total, Section: p.section}
+	innerCell.Width += width - p.config.Dimensions.Width
+	innerCell.Height += height - p.config.Dimensions.Height
 
 	prop := &props.Rect{}
 	prop.MakeValid()
@@ -40,14 +49,42 @@ func (p *Page) Render(provider core.Provider, cell entity.Cell) {
 		provider.AddBackgroundImageFromBytes(p.config.BackgroundImage.Bytes, &innerCell, prop, p.config.BackgroundImage.Extension)
 	}
 
-	for _, row := range p.rows {
+	pageCell := innerCell
+	if p.hasWatermark() && !p.config.Watermark.Prop.Foreground {
+		p.addWatermark(provider, &pageCell)
+	}
+
+	for i, row := range p.rows {
+		innerCell.Path.Row = i
 		row.Render(provider, innerCell)
-		innerCell.Y += row.GetHeight()
+		innerCell.Y += core.GetRowHeight(row, provider, &innerCell)
+	}
+
+	if p.hasWatermark() && p.config.Watermark.Prop.Foreground {
+		p.addWatermark(provider, &pageCell)
 	}
 
 	if p.prop.Pattern != "" {
-		provider.AddText(p.prop.GetPageString(p.number, p.total), &cell, p.prop.GetNumberTextProp(cell.Height))
+		numberCell := cell
+		numberCell.Width, numberCell.Height = innerCell.Width, innerCell.Height
+		pageString := p.prop.GetSectionPageString(p.number, p.total, p.section.Label(), p.section.Total)
+		provider.AddText(pageString, &numberCell, p.prop.GetNumberTextProp(numberCell.Height))
+	}
+}
+
+func (p *Page) hasWatermark() bool {
+	return p.config.Watermark != nil && p.config.Watermark.Prop.HasPage(p.number)
+}
+
+// addWatermark draws the watermark in the center of the useful area of the page.
+func (p *Page) addWatermark(provider core.Provider, cell *entity.Cell) {
+	watermark := p.config.Watermark
+	if watermark.Image != nil {
+		provider.AddImageWatermark(watermark.Image.Bytes, cell, &watermark.Prop, watermark.Image.Extension)
+		return
 	}
+
+	provider.AddTextWatermark(watermark.Text, cell, &watermark.Prop)
 }
 
 // SetConfig sets the Page configuration.
@@ -64,11 +101,21 @@ func (p *Page) SetNumber(number int, total int) {
 	p.total = total
 }
 
+// SetSection sets the section of the Page, with the number of the Page in it.
+func (p *Page) SetSection(section entity.Section) {
+	p.section = section
+}
+
 // GetNumber returns the Page number.
 func (p *Page) GetNumber() int {
 	return p.number
 }
 
+// GetProp returns the properties of the Page.
+func (p *Page) GetProp() props.Page {
+	return p.prop
+}
+
 // Add adds one or more rows to the Page.
 func (p *Page) Add(rows ...core.Row) core.Page {
 	p.rows = append(p.rows, rows...)
@@ -86,6 +133,18 @@ func (p *Page) GetStructure() *node.Node[core.Structure] {
 		Type: "page",
 	}
 
+	if p.prop.PageSize != "" || p.prop.Orientation != "" {
+		str.Details = map[string]interface{}{}
+	}
+
+	if p.prop.PageSize != "" {
+		str.Details["prop_page_size"] = p.prop.PageSize
+	}
+
+	if p.prop.Orientation != "" {
+		str.Details["prop_orientation"] = p.prop.Orientation
+	}
+
 	n := node.New(str)
 	for _, r := range p.rows {
 		inner := r.GetStructure()
@@ -3,16 +3,20 @@ package page_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/image"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/page"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/orientation"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagesize"
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/miguelbernadi/maroto/v2/pkg/props"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
-	"github.com/stretchr/testify/assert"
 )
 
 func TestNew(t *testing.T) {
@@ -48,11 +52,12 @@ func TestPage_Render(t *testing.T) {
 		cell := fixture.CellEntity()
 		prop := fixture.PageProp()
 		prop.Pattern = ""
-		cfg := &entity.Config{}
+		cfg := &entity.Config{Dimensions: &entity.Dimensions{Width: 210, Height: 297}}
 
 		provider := &mocks.Provider{}
+		provider.EXPECT().AddPage(210.0, 297.0)
 		row := &mocks.Row{}
-		row.EXPECT().Render(provider, cell)
+		row.EXPECT().Render(provider, withPage(cell))
 		row.EXPECT().GetHeight().Return(10.0)
 		row.EXPECT().SetConfig(cfg)
 
@@ -73,6 +78,7 @@ func TestPage_Render(t *testing.T) {
 		prop := fixture.PageProp()
 		prop.Pattern = ""
 		cfg := &entity.Config{
+			Dimensions: &entity.Dimensions{Width: 210, Height: 297},
 			BackgroundImage: &entity.Image{
 				Bytes:     []byte{1, 2, 3},
 				Extension: extension.Jpg,
@@ -83,9 +89,11 @@ func TestPage_Render(t *testing.T) {
 		rectProp.MakeValid()
 
 		provider := &mocks.Provider{}
-		provider.EXPECT().AddBackgroundImageFromBytes(cfg.BackgroundImage.Bytes, &cell, rectProp, cfg.BackgroundImage.Extension)
+		provider.EXPECT().AddPage(210.0, 297.0)
+		pageCell := withPage(cell)
+		provider.EXPECT().AddBackgroundImageFromBytes(cfg.BackgroundImage.Bytes, &pageCell, rectProp, cfg.BackgroundImage.Extension)
 		row := &mocks.Row{}
-		row.EXPECT().Render(provider, cell)
+		row.EXPECT().Render(provider, withPage(cell))
 		row.EXPECT().GetHeight().Return(10.0)
 		row.EXPECT().SetConfig(cfg)
 
@@ -106,6 +114,7 @@ func TestPage_Render(t *testing.T) {
 		cell := fixture.CellEntity()
 		prop := fixture.PageProp()
 		cfg := &entity.Config{
+			Dimensions: &entity.Dimensions{Width: 210, Height: 297},
 			BackgroundImage: &entity.Image{
 				Bytes:     []byte{1, 2, 3},
 				Extension: extension.Jpg,
@@ -116,10 +125,12 @@ func TestPage_Render(t *testing.T) {
 		rectProp.MakeValid()
 
 		provider := &mocks.Provider{}
-		provider.EXPECT().AddBackgroundImageFromBytes(cfg.BackgroundImage.Bytes, &cell, rectProp, cfg.BackgroundImage.Extension)
+		provider.EXPECT().AddPage(210.0, 297.0)
+		pageCell := withPage(cell)
+		provider.EXPECT().AddBackgroundImageFromBytes(cfg.BackgroundImage.Bytes, &pageCell, rectProp, cfg.BackgroundImage.Extension)
 		provider.EXPECT().AddText("0 / 0", &cell, prop.GetNumberTextProp(cell.Height))
 		row := &mocks.Row{}
-		row.EXPECT().Render(provider, cell)
+		row.EXPECT().Render(provider, withPage(cell))
 		row.EXPECT().GetHeight().Return(10.0)
 		row.EXPECT().SetConfig(cfg)
 
@@ -136,6 +147,222 @@ func TestPage_Render(t *testing.T) {
 		row.AssertNumberOfCalls(t, "Render", 1)
 		row.AssertNumberOfCalls(t, "GetHeight", 1)
 	})
+	t.Run("when page has its own orientation, should add page and render rows with its dimensions", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.PageProp()
+		prop.Pattern = ""
+		prop.Orientation = orientation.Horizontal
+		cfg := &entity.Config{Dimensions: &entity.Dimensions{Width: 210, Height: 297}}
+
+		innerCell := withPage(cell)
+		innerCell.Width += 87
+		innerCell.Height -= 87
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddPage(297.0, 210.0)
+		row := &mocks.Row{}
+		row.EXPECT().Render(provider, innerCell)
+		row.EXPECT().GetHeight().Return(10.0)
+		row.EXPECT().SetConfig(cfg)
+
+		sut := page.New(prop)
+		sut.Add(row)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddPage", 1)
+		row.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when page has number, should render rows with the number and total of pages in the cell", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.PageProp()
+		prop.Pattern = ""
+		cfg := &entity.Config{Dimensions: &entity.Dimensions{Width: 210, Height: 297}}
+
+		innerCell := cell
+		innerCell.Path = entity.Path{Page: 2}
+		innerCell.Page = &entity.PageNumber{Number: 2, Total: 5}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddPage(210.0, 297.0)
+		row := &mocks.Row{}
+		row.EXPECT().Render(provider, innerCell)
+		row.EXPECT().GetHeight().Return(10.0)
+		row.EXPECT().SetConfig(cfg)
+
+		sut := page.New(prop)
+		sut.Add(row)
+		sut.SetConfig(cfg)
+		sut.SetNumber(2, 5)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		row.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when page has section, should render rows with the section in the cell and write its page number", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.PageProp()
+		prop.Pattern = "{section} / {section_total}"
+		cfg := &entity.Config{Dimensions: &entity.Dimensions{Width: 210, Height: 297}}
+		section := entity.Section{Name: "preface", Numbering: numbering.LowerRoman, Number: 3, Total: 4}
+
+		innerCell := cell
+		innerCell.Path = entity.Path{Page: 4}
+		innerCell.Page = &entity.PageNumber{Number: 4, Total: 9, Section: section}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddPage(210.0, 297.0)
+		provider.EXPECT().AddText("iii / 4", &cell, prop.GetNumberTextProp(cell.Height))
+		row := &mocks.Row{}
+		row.EXPECT().Render(provider, innerCell)
+		row.EXPECT().GetHeight().Return(10.0)
+		row.EXPECT().SetConfig(cfg)
+
+		sut := page.New(prop)
+		sut.Add(row)
+		sut.SetConfig(cfg)
+		sut.SetNumber(4, 9)
+		sut.SetSection(section)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+		row.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when there is text watermark, should draw it below the rows", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.PageProp()
+		prop.Pattern = ""
+		cfg := &entity.Config{
+			Dimensions: &entity.Dimensions{Width: 210, Height: 297},
+			Watermark: &entity.Watermark{
+				Text: "DRAFT",
+				Prop: props.Watermark{Angle: 45},
+			},
+		}
+
+		var calls []string
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddPage(210.0, 297.0)
+		pageCell := withPage(cell)
+		provider.EXPECT().AddTextWatermark("DRAFT", &pageCell, &cfg.Watermark.Prop).Run(func(string, *entity.Cell, *props.Watermark) {
+			calls = append(calls, "watermark")
+		})
+		row := &mocks.Row{}
+		row.EXPECT().Render(provider, withPage(cell)).Run(func(core.Provider, entity.Cell) {
+			calls = append(calls, "row")
+		})
+		row.EXPECT().GetHeight().Return(10.0)
+		row.EXPECT().SetConfig(cfg)
+
+		sut := page.New(prop)
+		sut.Add(row)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddTextWatermark", 1)
+		assert.Equal(t, []string{"watermark", "row"}, calls)
+	})
+	t.Run("when there is image watermark in foreground, should draw it above the rows", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.PageProp()
+		prop.Pattern = ""
+		cfg := &entity.Config{
+			Dimensions: &entity.Dimensions{Width: 210, Height: 297},
+			Watermark: &entity.Watermark{
+				Image: &entity.Image{Bytes: []byte{1, 2, 3}, Extension: extension.Png},
+				Prop:  props.Watermark{Foreground: true},
+			},
+		}
+
+		var calls []string
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddPage(210.0, 297.0)
+		pageCell := withPage(cell)
+		provider.EXPECT().AddImageWatermark([]byte{1, 2, 3}, &pageCell, &cfg.Watermark.Prop, extension.Png).
+			Run(func([]byte, *entity.Cell, *props.Watermark, extension.Type) {
+				calls = append(calls, "watermark")
+			})
+		row := &mocks.Row{}
+		row.EXPECT().Render(provider, withPage(cell)).Run(func(core.Provider, entity.Cell) {
+			calls = append(calls, "row")
+		})
+		row.EXPECT().GetHeight().Return(10.0)
+		row.EXPECT().SetConfig(cfg)
+
+		sut := page.New(prop)
+		sut.Add(row)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddImageWatermark", 1)
+		assert.Equal(t, []string{"row", "watermark"}, calls)
+	})
+	t.Run("when watermark is not in the page, should not draw it", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.PageProp()
+		prop.Pattern = ""
+		cfg := &entity.Config{
+			Dimensions: &entity.Dimensions{Width: 210, Height: 297},
+			Watermark: &entity.Watermark{
+				Text: "DRAFT",
+				Prop: props.Watermark{Pages: []int{2}},
+			},
+		}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddPage(210.0, 297.0)
+		row := &mocks.Row{}
+		row.EXPECT().Render(provider, withPage(cell))
+		row.EXPECT().GetHeight().Return(10.0)
+		row.EXPECT().SetConfig(cfg)
+
+		sut := page.New(prop)
+		sut.Add(row)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddTextWatermark", 0)
+		row.AssertNumberOfCalls(t, "Render", 1)
+	})
+}
+
+func TestPage_GetProp(t *testing.T) {
+	t.Run("when called get prop, should return prop correctly", func(t *testing.T) {
+		// Arrange
+		prop := fixture.PageProp()
+		prop.PageSize = pagesize.A5
+
+		sut := page.New(prop)
+
+		// Act
+		pageProp := sut.GetProp()
+
+		// Assert
+		assert.Equal(t, prop, pageProp)
+	})
 }
 
 func TestPage_SetNumber(t *testing.T) {
@@ -166,3 +393,9 @@ func TestPage_GetRows(t *testing.T) {
 		assert.Equal(t, []core.Row{row}, rows)
 	})
 }
+
+// withPage returns the cell with the page number of a page which was not numbered.
+func withPage(cell entity.Cell) entity.Cell {
+	cell.Page = &entity.PageNumber{}
+	return cell
+}
@@ -0,0 +1,98 @@
+// Package pagenumber implements creation of page numbers.
+package pagenumber
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+type PageNumber struct {
+	pattern string
+	prop    props.Text
+	config  *entity.Config
+}
+
+// New is responsible to create an instance of a PageNumber. The pattern is
+// the text of the page number, where {current} and {total} are replaced by
+// the number of the page where it is rendered and the quantity of pages, and
+// {section} and {section_total} by the number of the page in its section and
+// the quantity of pages of the section.
+func New(pattern string, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	return &PageNumber{
+		pattern: pattern,
+		prop:    textProp,
+	}
+}
+
+// NewCol is responsible to create an instance of a PageNumber wrapped in a Col.
+func NewCol(size int, pattern string, ps ...props.Text) core.Col {
+	pageNumber := New(pattern, ps...)
+	return col.New(size).Add(pageNumber)
+}
+
+// NewRow is responsible to create an instance of a PageNumber wrapped in a Row.
+func NewRow(height float64, pattern string, ps ...props.Text) core.Row {
+	pageNumber := New(pattern, ps...)
+	c := col.New().Add(pageNumber)
+	return row.New(height).Add(c)
+}
+
+// GetStructure returns the Structure of a PageNumber.
+func (p *PageNumber) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "page_number",
+		Value:   p.pattern,
+		Details: p.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (p *PageNumber) SetConfig(config *entity.Config) {
+	p.config = config
+	p.prop.MakeValid(p.config.DefaultFont)
+}
+
+// GetHeight returns the height that the page number will occupy inside the cell.
+// As the page is only known when it is rendered, the height is measured with the
+// pattern, whose placeholders are wider than the numbers which replace them.
+func (p *PageNumber) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	width := cell.Width - p.prop.Left - p.prop.Right
+	amountLines := provider.GetLinesQuantity(p.pattern, &p.prop, width)
+
+	fontHeight := provider.GetTextHeight(&props.Font{
+		Family: p.prop.Family,
+		Style:  p.prop.Style,
+		Size:   p.prop.Size,
+	})
+
+	textHeight := float64(amountLines)*fontHeight + float64(amountLines-1)*p.prop.VerticalPadding
+	return textHeight + p.prop.Top
+}
+
+// Render renders a PageNumber into a PDF context, with the
+// number of the page where it is rendered.
+func (p *PageNumber) Render(provider core.Provider, cell *entity.Cell) {
+	if cell.Page == nil {
+		return
+	}
+
+	provider.AddText(p.getValue(cell.Page), cell, &p.prop)
+}
+
+func (p *PageNumber) getValue(number *entity.PageNumber) string {
+	page := props.Page{Pattern: p.pattern}
+	section := number.Section
+	return page.GetSectionPageString(number.Number, number.Total, section.Label(), section.Total)
+}
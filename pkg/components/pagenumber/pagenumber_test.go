@@ -0,0 +1,119 @@
+package pagenumber_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/internal/fixture"
+	"github.com/miguelbernadi/maroto/v2/mocks"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/pagenumber"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+	"github.com/miguelbernadi/maroto/v2/pkg/test"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := pagenumber.New("Page {current} of {total}")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/pagenumbers/new_page_number_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := pagenumber.New("Page {current} of {total}", fixture.TextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/pagenumbers/new_page_number_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := pagenumber.NewCol(6, "{current}/{total}")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/pagenumbers/new_page_number_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := pagenumber.NewRow(10, "{current}/{total}")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/pagenumbers/new_page_number_row.json")
+}
+
+func TestPageNumber_GetHeight(t *testing.T) {
+	t.Run("should return the height of the lines of the pattern", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.TextProp()
+		sut := pagenumber.New("Page {current} of {total}", prop)
+
+		width := cell.Width - prop.Left - prop.Right
+		font := &props.Font{Family: prop.Family, Style: prop.Style, Size: prop.Size}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetLinesQuantity("Page {current} of {total}", &prop, width).Return(1)
+		provider.EXPECT().GetTextHeight(font).Return(5.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 5.0+prop.Top, height)
+		provider.AssertNumberOfCalls(t, "GetLinesQuantity", 1)
+	})
+}
+
+func TestPageNumber_Render(t *testing.T) {
+	t.Run("should render the number of the page of the cell", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		cell.Page = &entity.PageNumber{Number: 2, Total: 5}
+		prop := fixture.TextProp()
+		sut := pagenumber.New("Page {current} of {total}", prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddText("Page 2 of 5", &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when the page is not known, should not render", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := pagenumber.New("Page {current} of {total}", fixture.TextProp())
+
+		provider := &mocks.Provider{}
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "AddText")
+	})
+}
+
+func TestPageNumber_SetConfig(t *testing.T) {
+	t.Run("should apply the default font", func(t *testing.T) {
+		// Arrange
+		sut := pagenumber.New("{current}")
+		fontProp := fixture.FontProp()
+		cfg := &entity.Config{
+			DefaultFont: &fontProp,
+		}
+
+		// Act
+		sut.SetConfig(cfg)
+
+		// Assert
+		assert.Equal(t, fontProp.Family, sut.GetStructure().GetData().Details["prop_font_family"])
+	})
+}
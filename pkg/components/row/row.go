@@ -2,6 +2,9 @@
 package row
 
 import (
+	"math"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagebreak"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 
 	"github.com/johnfercher/go-tree/node"
@@ -11,16 +14,28 @@ import (
 )
 
 type Row struct {
-	height float64
-	cols   []core.Col
-	style  *props.Cell
-	config *entity.Config
+	height       float64
+	autoHeight   bool
+	cols         []core.Col
+	style        *props.Cell
+	config       *entity.Config
+	header       []core.Row
+	anchor       *entity.Anchor
+	bookmark     *entity.Bookmark
+	destination  string
+	keepWithNext bool
+	pageBreak    pagebreak.Type
 }
 
-// New is responsible to create a core.Row.
-func New(height float64) core.Row {
+// New is responsible to create a core.Row. When the height is not
+// provided, the row height is defined by the highest col content.
+func New(height ...float64) core.Row {
+	if len(height) == 0 {
+		return &Row{autoHeight: true}
+	}
+
 	return &Row{
-		height: height,
+		height: height[0],
 	}
 }
 
@@ -38,14 +53,72 @@ func (r *Row) Add(cols ...core.Col) core.Row {
 	return r
 }
 
-// GetHeight returns the height of a core.Row.
+// GetHeight returns the height of a core.Row, which is zero when the
+// row was created without height, as the height is measured by Measure.
 func (r *Row) GetHeight() float64 {
 	return r.height
 }
 
+// IsAutoHeight returns true when the row was created without height.
+func (r *Row) IsAutoHeight() bool {
+	return r.autoHeight
+}
+
+// Measure returns the height of a core.Row. If the row was created
+// without height, the height is measured from the content of its cols
+// and the padding of the row.
+func (r *Row) Measure(provider core.Provider, cell *entity.Cell) float64 {
+	if !r.autoHeight {
+		return r.height
+	}
+
+	innerCell := cell.ApplyPadding(r.style)
+	colsWidth := r.getColsWidth(innerCell.Width)
+	greaterHeight := 0.0
+
+	for _, col := range r.cols {
+		innerCell.Width = r.getColWidth(col, colsWidth)
+
+		height := col.GetHeight(provider, &innerCell)
+		if height > greaterHeight {
+			greaterHeight = height
+		}
+	}
+
+	return greaterHeight + r.style.GetVerticalPadding()
+}
+
 // GetStructure returns the Structure of a core.Row.
 func (r *Row) GetStructure() *node.Node[core.Structure] {
 	detailsMap := r.style.ToMap()
+	hasDetails := r.autoHeight || r.anchor != nil || r.bookmark != nil || r.destination != "" || r.keepWithNext || r.pageBreak != ""
+	if detailsMap == nil && hasDetails {
+		detailsMap = make(map[string]interface{})
+	}
+
+	if r.autoHeight {
+		detailsMap["is_auto_height"] = true
+	}
+
+	if r.anchor != nil {
+		detailsMap = r.anchor.AppendMap(detailsMap)
+	}
+
+	if r.bookmark != nil {
+		detailsMap = r.bookmark.AppendMap(detailsMap)
+	}
+
+	if r.destination != "" {
+		detailsMap["destination"] = r.destination
+	}
+
+	if r.keepWithNext {
+		detailsMap["keep_with_next"] = true
+	}
+
+	if r.pageBreak != "" {
+		detailsMap["page_break_before"] = r.pageBreak
+	}
 
 	str := core.Structure{
 		Type:    "row",
@@ -63,26 +136,36 @@ func (r *Row) GetStructure() *node.Node[core.Structure] {
 	return node
 }
 
-// Render renders a Row into a PDF context.
+// Render renders a Row into a PDF context. The cols are placed inside the
+// padding of the row, separated by the gutter of the config.
 func (r *Row) Render(provider core.Provider, cell entity.Cell) {
-	cell.Height = r.height
-	innerCell := cell.Copy()
+	cell.Height = r.Measure(provider, &cell)
+	innerCell := cell.ApplyPadding(r.style)
+	colsWidth := r.getColsWidth(innerCell.Width)
 
 	if r.style != nil {
 		provider.CreateCol(cell.Width, cell.Height, r.config, r.style)
 	}
 
-	for _, col := range r.cols {
-		size := col.GetSize()
-		parentWidth := cell.Width
+	for i, col := range r.cols {
+		if i > 0 && r.style == nil && r.config.Gutter > 0 {
+			provider.SetPosition(innerCell.X, innerCell.Y)
+		}
 
-		percent := float64(size) / float64(r.config.MaxGridSize)
-
-		colDimension := parentWidth * percent
+		colDimension := r.getColWidth(col, colsWidth)
 		innerCell.Width = colDimension
+		innerCell.Path.Col = i
 
 		col.Render(provider, innerCell, r.style == nil)
-		innerCell.X += colDimension
+		innerCell.X += colDimension + r.config.Gutter
+	}
+
+	if r.bookmark != nil {
+		provider.AddBookmark(r.bookmark.Title, r.bookmark.Level)
+	}
+
+	if r.destination != "" {
+		provider.AddDestination(r.destination)
 	}
 
 	provider.CreateRow(cell.Height)
@@ -93,3 +176,154 @@ func (r *Row) WithStyle(style *props.Cell) core.Row {
 	r.style = style
 	return r
 }
+
+// WithRepeatedHeader sets rows that are added again before this Row
+// when it is moved to a new page, e.g. the header of a table.
+func (r *Row) WithRepeatedHeader(rows ...core.Row) core.Row {
+	r.header = rows
+	return r
+}
+
+// GetRepeatedHeader returns the rows that are added again before this Row
+// when it is moved to a new page.
+func (r *Row) GetRepeatedHeader() []core.Row {
+	return r.header
+}
+
+// WithAnchor marks a Row as a heading of the document, which is listed in the
+// table of contents with the page where the Row was placed, numbered as in its section.
+func (r *Row) WithAnchor(title string, level int) core.Row {
+	r.anchor = &entity.Anchor{
+		Title: title,
+		Level: level,
+	}
+	return r
+}
+
+// GetAnchor returns the anchor of a Row, or nil when the Row is not a heading.
+func (r *Row) GetAnchor() *entity.Anchor {
+	return r.anchor
+}
+
+// WithBookmark adds an entry to the PDF outline pointing to this Row.
+// Level 0 is a top level entry, and each level must be at most one
+// deeper than the previous entry.
+func (r *Row) WithBookmark(title string, level int) core.Row {
+	r.bookmark = &entity.Bookmark{
+		Title: title,
+		Level: level,
+	}
+	return r
+}
+
+// GetBookmark returns the bookmark of a Row, or nil when the Row has no bookmark.
+func (r *Row) GetBookmark() *entity.Bookmark {
+	return r.bookmark
+}
+
+// WithDestination names the position of this Row, so texts with
+// props.Text.InternalLink equal to the name jump to it when clicked.
+func (r *Row) WithDestination(name string) core.Row {
+	r.destination = name
+	return r
+}
+
+// GetDestination returns the name of the position of a Row, or an empty
+// string when the Row is not a destination.
+func (r *Row) GetDestination() string {
+	return r.destination
+}
+
+// Split splits a Row created without height in two rows, where the first row has
+// the content which fits in the height and the second row has the rest of the
+// content, which continues in the next page. The second row is nil when the
+// Row cannot be split, because it has a fixed height or nothing is split.
+func (r *Row) Split(provider core.Provider, cell *entity.Cell, height float64) (core.Row, core.Row) {
+	if !r.autoHeight {
+		return r, nil
+	}
+
+	first := &Row{
+		autoHeight:  true,
+		style:       r.style,
+		config:      r.config,
+		anchor:      r.anchor,
+		bookmark:    r.bookmark,
+		destination: r.destination,
+		pageBreak:   r.pageBreak,
+	}
+
+	rest := &Row{
+		autoHeight:   true,
+		style:        r.style,
+		config:       r.config,
+		header:       r.header,
+		keepWithNext: r.keepWithNext,
+	}
+
+	innerCell := cell.ApplyPadding(r.style)
+	colsWidth := r.getColsWidth(innerCell.Width)
+	contentHeight := height - r.style.GetVerticalPadding()
+	split := false
+
+	for _, col := range r.cols {
+		innerCell.Width = r.getColWidth(col, colsWidth)
+
+		firstCol, restCol, colSplit := col.Split(provider, &innerCell, contentHeight)
+		first.cols = append(first.cols, firstCol)
+		rest.cols = append(rest.cols, restCol)
+		split = split || colSplit
+	}
+
+	if !split {
+		return r, nil
+	}
+
+	return first, rest
+}
+
+// KeepWithNext keeps this Row in the same page of the next Row added to the
+// document, e.g. a heading with the first row of its content. When the rows
+// kept together do not fit in the current page, they are moved to a new page.
+func (r *Row) KeepWithNext() core.Row {
+	r.keepWithNext = true
+	return r
+}
+
+// IsKeptWithNext returns true when the Row is kept in the same page of the next Row.
+func (r *Row) IsKeptWithNext() bool {
+	return r.keepWithNext
+}
+
+// WithPageBreakBefore starts a new page before this Row, e.g. in the title of
+// a chapter. The page break is pagebreak.Next when it is not provided, and with
+// pagebreak.Odd the Row starts in the next odd page, for duplex printing.
+func (r *Row) WithPageBreakBefore(breakType ...pagebreak.Type) core.Row {
+	r.pageBreak = pagebreak.Next
+	if len(breakType) > 0 && breakType[0].IsValid() {
+		r.pageBreak = breakType[0]
+	}
+
+	return r
+}
+
+// GetPageBreakBefore returns the page break before the Row,
+// or an empty page break when the Row has no page break.
+func (r *Row) GetPageBreakBefore() pagebreak.Type {
+	return r.pageBreak
+}
+
+// getColsWidth returns the width shared by the cols, which is
+// the width of the row without the gutters between the cols.
+func (r *Row) getColsWidth(width float64) float64 {
+	if len(r.cols) < 2 {
+		return width
+	}
+
+	return math.Max(width-r.config.Gutter*float64(len(r.cols)-1), 0)
+}
+
+func (r *Row) getColWidth(col core.Col, parentWidth float64) float64 {
+	percent := float64(col.GetSize()) / float64(r.config.MaxGridSize)
+	return parentWidth * percent
+}
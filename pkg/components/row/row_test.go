@@ -1,15 +1,22 @@
 package row_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/text"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagebreak"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
-	"github.com/stretchr/testify/assert"
 )
 
 func TestNew(t *testing.T) {
@@ -35,6 +42,34 @@ func TestNew(t *testing.T) {
 		// Assert
 		test.New(t).Assert(r.GetStructure()).Equals("components/rows/new_col_with_prop.json")
 	})
+	t.Run("when height is not defined, should apply auto height", func(t *testing.T) {
+		// Act
+		r := row.New().Add(col.New(12))
+
+		// Assert
+		test.New(t).Assert(r.GetStructure()).Equals("components/rows/new_auto_height.json")
+	})
+	t.Run("when has anchor, should retrieve anchor", func(t *testing.T) {
+		// Act
+		r := row.New(10).Add(col.New(12)).WithAnchor("Chapter 1", 1)
+
+		// Assert
+		test.New(t).Assert(r.GetStructure()).Equals("components/rows/new_with_anchor.json")
+	})
+	t.Run("when has bookmark, should retrieve bookmark", func(t *testing.T) {
+		// Act
+		r := row.New(10).Add(col.New(12)).WithBookmark("Chapter 1", 1)
+
+		// Assert
+		test.New(t).Assert(r.GetStructure()).Equals("components/rows/new_with_bookmark.json")
+	})
+	t.Run("when has destination, should retrieve destination", func(t *testing.T) {
+		// Act
+		r := row.New(10).Add(col.New(12)).WithDestination("details")
+
+		// Assert
+		test.New(t).Assert(r.GetStructure()).Equals("components/rows/new_with_destination.json")
+	})
 }
 
 func TestRow_GetHeight(t *testing.T) {
@@ -45,6 +80,118 @@ func TestRow_GetHeight(t *testing.T) {
 		// Assert
 		assert.Equal(t, 10.0, r.GetHeight())
 	})
+	t.Run("when height is not defined, should return zero", func(t *testing.T) {
+		// Act
+		r := row.New()
+
+		// Assert
+		assert.Equal(t, 0.0, r.GetHeight())
+	})
+}
+
+func TestRow_IsAutoHeight(t *testing.T) {
+	t.Run("when height is defined, should return false", func(t *testing.T) {
+		// Act
+		r := row.New(10)
+
+		// Assert
+		assert.False(t, r.(core.Measurable).IsAutoHeight())
+	})
+	t.Run("when height is not defined, should return true", func(t *testing.T) {
+		// Act
+		r := row.New()
+
+		// Assert
+		assert.True(t, r.(core.Measurable).IsAutoHeight())
+	})
+}
+
+func TestRow_Measure(t *testing.T) {
+	t.Run("when height is defined, should return the height", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		// Act
+		r := row.New(10)
+
+		// Assert
+		assert.Equal(t, 10.0, r.(core.Measurable).Measure(provider, &cell))
+	})
+	t.Run("when height is not defined, should return the greater col height", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+		}
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		firstColCell := cell.Copy()
+		firstColCell.Width = cell.Width / 2
+		firstCol := &mocks.Col{}
+		firstCol.EXPECT().SetConfig(cfg)
+		firstCol.EXPECT().GetSize().Return(6)
+		firstCol.EXPECT().GetHeight(provider, &firstColCell).Return(15.0)
+
+		secondColCell := cell.Copy()
+		secondColCell.Width = cell.Width / 4
+		secondCol := &mocks.Col{}
+		secondCol.EXPECT().SetConfig(cfg)
+		secondCol.EXPECT().GetSize().Return(3)
+		secondCol.EXPECT().GetHeight(provider, &secondColCell).Return(25.0)
+
+		sut := row.New().Add(firstCol, secondCol)
+		sut.SetConfig(cfg)
+
+		// Act
+		height := sut.(core.Measurable).Measure(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 25.0, height)
+		firstCol.AssertNumberOfCalls(t, "GetHeight", 1)
+		secondCol.AssertNumberOfCalls(t, "GetHeight", 1)
+	})
+	t.Run("when height is not defined and there is padding, should measure cols inside the padding", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+			Gutter:      2,
+		}
+		cell := entity.Cell{X: 0, Y: 0, Width: 100, Height: 50}
+		provider := &mocks.Provider{}
+
+		colCell := entity.Cell{X: 5, Y: 1, Width: 44, Height: 46}
+		firstCol := &mocks.Col{}
+		firstCol.EXPECT().SetConfig(cfg)
+		firstCol.EXPECT().GetSize().Return(6)
+		firstCol.EXPECT().GetHeight(provider, &colCell).Return(15.0)
+
+		secondCol := &mocks.Col{}
+		secondCol.EXPECT().SetConfig(cfg)
+		secondCol.EXPECT().GetSize().Return(6)
+		secondCol.EXPECT().GetHeight(provider, &colCell).Return(10.0)
+
+		style := &props.Cell{PaddingLeft: 5, PaddingTop: 1, PaddingRight: 5, PaddingBottom: 3}
+		sut := row.New().Add(firstCol, secondCol).WithStyle(style)
+		sut.SetConfig(cfg)
+
+		// Act
+		height := sut.(core.Measurable).Measure(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 19.0, height)
+	})
+	t.Run("when height is not defined and there is no cols, should return zero", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		// Act
+		r := row.New()
+
+		// Assert
+		assert.Equal(t, 0.0, r.(core.Measurable).Measure(provider, &cell))
+	})
 }
 
 func TestRow_GetStructure(t *testing.T) {
@@ -103,6 +250,161 @@ func TestRow_GetStructure(t *testing.T) {
 		col.AssertNumberOfCalls(t, "Render", 1)
 		col.AssertNumberOfCalls(t, "SetConfig", 1)
 	})
+	t.Run("when there are many cols, should render each col with its index in the path", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+		}
+		cell := fixture.CellEntity()
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().CreateRow(cell.Height)
+
+		firstCell := cell.Copy()
+		firstCell.Width = cell.Width / 2
+		secondCell := firstCell.Copy()
+		secondCell.X += firstCell.Width
+		secondCell.Path.Col = 1
+
+		first := &mocks.Col{}
+		first.EXPECT().Render(provider, firstCell, true)
+		first.EXPECT().SetConfig(cfg)
+		first.EXPECT().GetSize().Return(6)
+
+		second := &mocks.Col{}
+		second.EXPECT().Render(provider, secondCell, true)
+		second.EXPECT().SetConfig(cfg)
+		second.EXPECT().GetSize().Return(6)
+
+		sut := row.New(cell.Height).Add(first, second)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		first.AssertCalled(t, "Render", provider, firstCell, true)
+		second.AssertCalled(t, "Render", provider, secondCell, true)
+	})
+	t.Run("when there is gutter, should separate the cols", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+			Gutter:      4,
+		}
+		cell := entity.Cell{X: 0, Y: 10, Width: 104, Height: 20}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().SetPosition(54.0, 10.0)
+		provider.EXPECT().CreateRow(20.0)
+
+		firstCell := entity.Cell{X: 0, Y: 10, Width: 50, Height: 20}
+		secondCell := entity.Cell{X: 54, Y: 10, Width: 50, Height: 20, Path: entity.Path{Col: 1}}
+
+		first := &mocks.Col{}
+		first.EXPECT().Render(provider, firstCell, true)
+		first.EXPECT().SetConfig(cfg)
+		first.EXPECT().GetSize().Return(6)
+
+		second := &mocks.Col{}
+		second.EXPECT().Render(provider, secondCell, true)
+		second.EXPECT().SetConfig(cfg)
+		second.EXPECT().GetSize().Return(6)
+
+		sut := row.New(20).Add(first, second)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		first.AssertCalled(t, "Render", provider, firstCell, true)
+		second.AssertCalled(t, "Render", provider, secondCell, true)
+		provider.AssertNumberOfCalls(t, "SetPosition", 1)
+	})
+	t.Run("when there is padding in style, should render the cols inside the padding", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+		}
+		cell := entity.Cell{X: 0, Y: 10, Width: 100, Height: 20}
+		style := &props.Cell{PaddingLeft: 2, PaddingTop: 3, PaddingRight: 8, PaddingBottom: 1}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().CreateCol(100.0, 20.0, cfg, style)
+		provider.EXPECT().CreateRow(20.0)
+
+		colCell := entity.Cell{X: 2, Y: 13, Width: 90, Height: 16}
+
+		column := &mocks.Col{}
+		column.EXPECT().Render(provider, colCell, false)
+		column.EXPECT().SetConfig(cfg)
+		column.EXPECT().GetSize().Return(12)
+
+		sut := row.New(20).Add(column).WithStyle(style)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		column.AssertCalled(t, "Render", provider, colCell, false)
+		provider.AssertNumberOfCalls(t, "CreateCol", 1)
+	})
+	t.Run("when there is bookmark, should add bookmark before create row", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+		}
+		cell := fixture.CellEntity()
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddBookmark("Chapter 1", 0)
+		provider.EXPECT().CreateRow(cell.Height)
+
+		col := &mocks.Col{}
+		col.EXPECT().Render(provider, cell, true)
+		col.EXPECT().SetConfig(cfg)
+		col.EXPECT().GetSize().Return(12)
+
+		sut := row.New(cell.Height).Add(col).WithBookmark("Chapter 1", 0)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBookmark", 1)
+		provider.AssertNumberOfCalls(t, "CreateRow", 1)
+		col.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when there is destination, should add destination before create row", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{
+			MaxGridSize: 12,
+		}
+		cell := fixture.CellEntity()
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().AddDestination("details")
+		provider.EXPECT().CreateRow(cell.Height)
+
+		col := &mocks.Col{}
+		col.EXPECT().Render(provider, cell, true)
+		col.EXPECT().SetConfig(cfg)
+		col.EXPECT().GetSize().Return(12)
+
+		sut := row.New(cell.Height).Add(col).WithDestination("details")
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddDestination", 1)
+		provider.AssertNumberOfCalls(t, "CreateRow", 1)
+		col.AssertNumberOfCalls(t, "Render", 1)
+	})
 }
 
 func TestRow_SetConfig(t *testing.T) {
@@ -114,3 +416,212 @@ func TestRow_SetConfig(t *testing.T) {
 		sut.SetConfig(nil)
 	})
 }
+
+func TestRow_WithRepeatedHeader(t *testing.T) {
+	t.Run("when repeated header is not defined, should return nil", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10)
+
+		// Act
+		header := sut.GetRepeatedHeader()
+
+		// Assert
+		assert.Nil(t, header)
+	})
+	t.Run("when repeated header is defined, should return it", func(t *testing.T) {
+		// Arrange
+		headerRow := row.New(5)
+		sut := row.New(10).WithRepeatedHeader(headerRow)
+
+		// Act
+		header := sut.GetRepeatedHeader()
+
+		// Assert
+		assert.Equal(t, []core.Row{headerRow}, header)
+	})
+}
+
+func TestRow_WithAnchor(t *testing.T) {
+	t.Run("when anchor is not defined, should return nil", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10)
+
+		// Act
+		anchor := sut.GetAnchor()
+
+		// Assert
+		assert.Nil(t, anchor)
+	})
+	t.Run("when anchor is defined, should return it", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10).WithAnchor("Chapter 1", 1)
+
+		// Act
+		anchor := sut.GetAnchor()
+
+		// Assert
+		assert.Equal(t, &entity.Anchor{Title: "Chapter 1", Level: 1}, anchor)
+	})
+}
+
+func TestRow_WithBookmark(t *testing.T) {
+	t.Run("when bookmark is not defined, should return nil", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10)
+
+		// Act
+		bookmark := sut.GetBookmark()
+
+		// Assert
+		assert.Nil(t, bookmark)
+	})
+	t.Run("when bookmark is defined, should return it", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10).WithBookmark("Chapter 1", 1)
+
+		// Act
+		bookmark := sut.GetBookmark()
+
+		// Assert
+		assert.Equal(t, &entity.Bookmark{Title: "Chapter 1", Level: 1}, bookmark)
+	})
+}
+
+func TestRow_WithDestination(t *testing.T) {
+	t.Run("when destination is not defined, should return empty", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10)
+
+		// Act
+		destination := sut.GetDestination()
+
+		// Assert
+		assert.Empty(t, destination)
+	})
+	t.Run("when destination is defined, should return it", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10).WithDestination("details")
+
+		// Act
+		destination := sut.GetDestination()
+
+		// Assert
+		assert.Equal(t, "details", destination)
+	})
+}
+
+func TestRow_KeepWithNext(t *testing.T) {
+	t.Run("when keep with next is not defined, should return false", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10)
+
+		// Act
+		kept := sut.IsKeptWithNext()
+
+		// Assert
+		assert.False(t, kept)
+	})
+	t.Run("when keep with next is defined, should return true", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10).KeepWithNext()
+
+		// Act
+		kept := sut.IsKeptWithNext()
+
+		// Assert
+		assert.True(t, kept)
+		assert.Equal(t, true, sut.GetStructure().GetData().Details["keep_with_next"])
+	})
+}
+
+func TestRow_WithPageBreakBefore(t *testing.T) {
+	t.Run("when page break is not defined, should return empty page break", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10)
+
+		// Act
+		breakType := sut.GetPageBreakBefore()
+
+		// Assert
+		assert.Equal(t, pagebreak.Type(""), breakType)
+	})
+	t.Run("when page break is defined without type, should break to next page", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10).WithPageBreakBefore()
+
+		// Act
+		breakType := sut.GetPageBreakBefore()
+
+		// Assert
+		assert.Equal(t, pagebreak.Next, breakType)
+		assert.Equal(t, pagebreak.Next, sut.GetStructure().GetData().Details["page_break_before"])
+	})
+	t.Run("when page break is defined with odd type, should break to odd page", func(t *testing.T) {
+		// Arrange
+		sut := row.New(10).WithPageBreakBefore(pagebreak.Odd)
+
+		// Act
+		breakType := sut.GetPageBreakBefore()
+
+		// Assert
+		assert.Equal(t, pagebreak.Odd, breakType)
+	})
+}
+
+func TestRow_Split(t *testing.T) {
+	t.Run("when height is defined, should not split", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+		sut := row.New(10).Add(text.NewCol(12, "a b c"))
+
+		// Act
+		first, rest := sut.Split(provider, &cell, 5)
+
+		// Assert
+		assert.Equal(t, sut, first)
+		assert.Nil(t, rest)
+	})
+	t.Run("when height is not defined and text does not fit, should split the cols", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(5.0)
+		provider.EXPECT().GetLinesQuantity(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(value string, _ *props.Text, _ float64) int {
+				return len(strings.Fields(value))
+			})
+
+		sut := row.New().Add(text.NewCol(6, "a b c"), col.New(6))
+		sut.SetConfig(&entity.Config{MaxGridSize: 12, DefaultFont: &props.Font{}})
+
+		// Act
+		first, rest := sut.Split(provider, &cell, 10)
+
+		// Assert
+		assert.Len(t, first.GetStructure().GetNexts(), 2)
+		assert.Equal(t, "a b", first.GetStructure().GetNexts()[0].GetNexts()[0].GetData().Value)
+		assert.Len(t, rest.GetStructure().GetNexts(), 2)
+		assert.Equal(t, "c", rest.GetStructure().GetNexts()[0].GetNexts()[0].GetData().Value)
+	})
+	t.Run("when row has page break, should keep the page break only in the first part", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(5.0)
+		provider.EXPECT().GetLinesQuantity(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(value string, _ *props.Text, _ float64) int {
+				return len(strings.Fields(value))
+			})
+
+		sut := row.New().Add(text.NewCol(12, "a b c")).WithPageBreakBefore(pagebreak.Odd)
+		sut.SetConfig(&entity.Config{MaxGridSize: 12, DefaultFont: &props.Font{}})
+
+		// Act
+		first, rest := sut.Split(provider, &cell, 10)
+
+		// Assert
+		assert.Equal(t, pagebreak.Odd, first.GetPageBreakBefore())
+		assert.Equal(t, pagebreak.Type(""), rest.GetPageBreakBefore())
+	})
+}
@@ -46,11 +46,15 @@ func NewRow(height float64, value string, ps ...props.Signature) core.Row {
 	return row.New(height).Add(c)
 }
 
+// GetHeight returns the height of a Signature, which reserves the same
+// space used by the text to sign above the line.
+func (s *Signature) GetHeight(provider core.Provider, _ *entity.Cell) float64 {
+	return s.getFontSize(provider) * 2
+}
+
 // Render renders a Signature into a PDF context.
 func (s *Signature) Render(provider core.Provider, cell *entity.Cell) {
-	fontProp := s.prop.ToFontProp()
-	safePadding := 1.5
-	fontSize := provider.GetTextHeight(fontProp) * safePadding
+	fontSize := s.getFontSize(provider)
 
 	textProp := s.prop.ToTextProp(align.Center, cell.Height-fontSize, 0)
 
@@ -75,3 +79,9 @@ func (s *Signature) GetStructure() *node.Node[core.Structure] {
 func (s *Signature) SetConfig(config *entity.Config) {
 	s.config = config
 }
+
+func (s *Signature) getFontSize(provider core.Provider) float64 {
+	fontProp := s.prop.ToFontProp()
+	safePadding := 1.5
+	return provider.GetTextHeight(fontProp) * safePadding
+}
@@ -3,11 +3,13 @@ package signature_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/signature"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
-	"github.com/stretchr/testify/mock"
 )
 
 func TestNew(t *testing.T) {
@@ -61,6 +63,25 @@ func TestNewRow(t *testing.T) {
 	})
 }
 
+func TestSignature_GetHeight(t *testing.T) {
+	t.Run("should reserve space for the text and the line", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.SignatureProp()
+		sut := signature.New("signature", prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(prop.ToFontProp()).Return(10.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 30.0, height)
+		provider.AssertNumberOfCalls(t, "GetTextHeight", 1)
+	})
+}
+
 func TestSignature_Render(t *testing.T) {
 	t.Run("should call provider correctly", func(t *testing.T) {
 		// Arrange
@@ -0,0 +1,110 @@
+// Package table implements creation of tables.
+package table
+
+import (
+	"errors"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/text"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+// Column defines how a field of T is presented in a table.
+type Column[T any] struct {
+	// Title is the text of the column in the header row.
+	Title string
+	// Size is the grid size of the column.
+	Size int
+	// Align of the title, content and total of the column.
+	Align align.Type
+	// Format returns the content of the column to one item.
+	Format func(item T) string
+	// Total returns the content of the column in the totals row. The totals row
+	// is only added when at least one column defines it.
+	Total func(items []T) string
+}
+
+// Build is responsible to receive a collection of objects and the columns that
+// describe them, and build the rows of a table. The header row is repeated on
+// every page that the table crosses.
+func Build[T any](items []T, columns []Column[T], ps ...props.Table) ([]core.Row, error) {
+	if len(columns) == 0 {
+		return nil, errors.New("empty columns")
+	}
+
+	for _, column := range columns {
+		if column.Format == nil {
+			return nil, errors.New("column without format")
+		}
+	}
+
+	prop := props.Table{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	header := newRow(prop.RowHeight)
+	for _, column := range columns {
+		header.Add(text.NewCol(column.Size, column.Title, withAlign(prop.HeaderProp, column.Align)))
+	}
+	header.WithStyle(prop.HeaderStyle)
+
+	rows := []core.Row{header}
+
+	for i, item := range items {
+		r := newRow(prop.RowHeight)
+		for _, column := range columns {
+			r.Add(text.NewCol(column.Size, column.Format(item), withAlign(prop.ContentProp, column.Align)))
+		}
+
+		if i%2 == 1 {
+			r.WithStyle(prop.AlternatedStyle)
+		}
+
+		rows = append(rows, r.WithRepeatedHeader(header))
+	}
+
+	if !hasTotal(columns) {
+		return rows, nil
+	}
+
+	total := newRow(prop.RowHeight)
+	for _, column := range columns {
+		if column.Total == nil {
+			total.Add(col.New(column.Size))
+			continue
+		}
+
+		total.Add(text.NewCol(column.Size, column.Total(items), withAlign(prop.TotalProp, column.Align)))
+	}
+	total.WithStyle(prop.TotalStyle)
+
+	return append(rows, total.WithRepeatedHeader(header)), nil
+}
+
+func newRow(height float64) core.Row {
+	if height == 0 {
+		return row.New()
+	}
+
+	return row.New(height)
+}
+
+func withAlign(prop props.Text, align align.Type) props.Text {
+	prop.Align = align
+	return prop
+}
+
+func hasTotal[T any](columns []Column[T]) bool {
+	for _, column := range columns {
+		if column.Total != nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,141 @@
+package table_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/internal/fixture"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/page"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/table"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+	"github.com/miguelbernadi/maroto/v2/pkg/test"
+)
+
+type anyType struct {
+	Key   string
+	Value int
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("when columns are empty, should return error", func(t *testing.T) {
+		// Act
+		r, err := table.Build[anyType](buildList(2), nil)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, r)
+	})
+	t.Run("when column has no format, should return error", func(t *testing.T) {
+		// Arrange
+		columns := []table.Column[anyType]{
+			{Title: "Key", Size: 6},
+		}
+
+		// Act
+		r, err := table.Build(buildList(2), columns)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, r)
+	})
+	t.Run("when items are empty, should return only the header", func(t *testing.T) {
+		// Act
+		r, err := table.Build(nil, buildColumns(false))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, r, 1)
+		assert.Nil(t, r[0].GetRepeatedHeader())
+	})
+	t.Run("when items are not empty, should return header and content rows", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellProp()
+		prop := props.Table{
+			HeaderStyle:     &cell,
+			AlternatedStyle: &cell,
+		}
+
+		// Act
+		r, err := table.Build(buildList(4), buildColumns(false), prop)
+		p := page.New().Add(r...)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, r, 5)
+		for _, content := range r[1:] {
+			assert.Equal(t, r[0], content.GetRepeatedHeader()[0])
+		}
+		test.New(t).Assert(p.GetStructure()).Equals("components/table/build.json")
+	})
+	t.Run("when row height is defined, should use fixed height rows", func(t *testing.T) {
+		// Arrange
+		prop := props.Table{RowHeight: 7}
+
+		// Act
+		r, err := table.Build(buildList(2), buildColumns(false), prop)
+		p := page.New().Add(r...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/table/build_fixed_height.json")
+	})
+	t.Run("when column has total, should add totals row", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellProp()
+		prop := props.Table{TotalStyle: &cell}
+
+		// Act
+		r, err := table.Build(buildList(3), buildColumns(true), prop)
+		p := page.New().Add(r...)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, r, 5)
+		assert.Equal(t, r[0], r[4].GetRepeatedHeader()[0])
+		test.New(t).Assert(p.GetStructure()).Equals("components/table/build_with_total.json")
+	})
+}
+
+func buildColumns(withTotal bool) []table.Column[anyType] {
+	columns := []table.Column[anyType]{
+		{
+			Title:  "Key",
+			Size:   8,
+			Format: func(item anyType) string { return item.Key },
+		},
+		{
+			Title:  "Value",
+			Size:   4,
+			Align:  align.Right,
+			Format: func(item anyType) string { return fmt.Sprintf("%d", item.Value) },
+		},
+	}
+
+	if withTotal {
+		columns[1].Total = func(items []anyType) string {
+			sum := 0
+			for _, item := range items {
+				sum += item.Value
+			}
+			return fmt.Sprintf("%d", sum)
+		}
+	}
+
+	return columns
+}
+
+func buildList(qtd int) []anyType {
+	var arr []anyType
+
+	for i := 0; i < qtd; i++ {
+		arr = append(arr, anyType{
+			Key:   fmt.Sprintf("key(%d)", i),
+			Value: i * 10,
+		})
+	}
+
+	return arr
+}
@@ -2,10 +2,14 @@
 package text
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/johnfercher/go-tree/node"
 
 	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/breakline"
 	"github.com/miguelbernadi/maroto/v2/pkg/core"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/miguelbernadi/maroto/v2/pkg/props"
@@ -60,7 +64,76 @@ func (t *Text) SetConfig(config *entity.Config) {
 	t.prop.MakeValid(t.config.DefaultFont)
 }
 
+// GetHeight returns the height that the text will occupy inside the cell,
+// considering the quantity of lines, the vertical padding and the top space.
+func (t *Text) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	width := cell.Width - t.prop.Left - t.prop.Right
+	amountLines := provider.GetLinesQuantity(t.value, &t.prop, width)
+
+	fontHeight := provider.GetTextHeight(&props.Font{
+		Family: t.prop.Family,
+		Style:  t.prop.Style,
+		Size:   t.prop.Size,
+	})
+
+	textHeight := float64(amountLines)*fontHeight + float64(amountLines-1)*t.prop.VerticalPadding
+	return textHeight + t.prop.Top
+}
+
+// Split splits the Text in a Text with the lines which fit in the height and
+// a Text with the rest of the lines, which continues in the next page without
+// the top space. The first Text is nil when no line fits in the height, and the
+// second Text is nil when the whole Text fits in the height.
+func (t *Text) Split(provider core.Provider, cell *entity.Cell, height float64) (core.Component, core.Component) {
+	width := cell.Width - t.prop.Left - t.prop.Right
+	fontHeight := provider.GetTextHeight(&props.Font{
+		Family: t.prop.Family,
+		Style:  t.prop.Style,
+		Size:   t.prop.Size,
+	})
+
+	maxLines := int((height - t.prop.Top + t.prop.VerticalPadding) / (fontHeight + t.prop.VerticalPadding))
+	if maxLines <= 0 {
+		return nil, t
+	}
+
+	parts, separator := t.getParts()
+
+	// As lines are filled in order, the greatest quantity of parts
+	// which fits in the lines is found by a binary search.
+	fitParts := sort.Search(len(parts)+1, func(i int) bool {
+		value := strings.Join(parts[:i], separator)
+		return provider.GetLinesQuantity(value, &t.prop, width) > maxLines
+	}) - 1
+
+	if fitParts >= len(parts) {
+		return t, nil
+	}
+
+	if fitParts <= 0 {
+		return nil, t
+	}
+
+	restProp := t.prop
+	restProp.Top = 0
+
+	first := &Text{value: strings.Join(parts[:fitParts], separator), prop: t.prop, config: t.config}
+	rest := &Text{value: strings.Join(parts[fitParts:], separator), prop: restProp, config: t.config}
+
+	return first, rest
+}
+
 // Render renders a Text into a PDF context.
 func (t *Text) Render(provider core.Provider, cell *entity.Cell) {
 	provider.AddText(t.value, cell, &t.prop)
 }
+
+// getParts returns the parts in which the text can be broken in lines,
+// which are words or characters depending on the break line strategy.
+func (t *Text) getParts() ([]string, string) {
+	if t.prop.BreakLineStrategy == breakline.DashStrategy {
+		return strings.Split(t.value, ""), ""
+	}
+
+	return strings.Split(t.value, " "), " "
+}
@@ -1,12 +1,19 @@
 package text_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/miguelbernadi/maroto/v2/internal/fixture"
 	"github.com/miguelbernadi/maroto/v2/mocks"
 	"github.com/miguelbernadi/maroto/v2/pkg/components/text"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/breakline"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
 	"github.com/miguelbernadi/maroto/v2/pkg/test"
 )
 
@@ -25,6 +32,16 @@ func TestNew(t *testing.T) {
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_text_custom_prop.json")
 	})
+	t.Run("when internal link is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		name := "details"
+
+		// Act
+		sut := text.New("code", props.Text{InternalLink: &name})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/texts/new_text_internal_link.json")
+	})
 }
 
 func TestNewCol(t *testing.T) {
@@ -61,6 +78,111 @@ func TestNewRow(t *testing.T) {
 	})
 }
 
+func TestText_GetHeight(t *testing.T) {
+	t.Run("should return the height of all lines", func(t *testing.T) {
+		// Arrange
+		value := "textValue"
+		cell := fixture.CellEntity()
+		prop := fixture.TextProp()
+		sut := text.New(value, prop)
+
+		width := cell.Width - prop.Left - prop.Right
+		font := &props.Font{Family: prop.Family, Style: prop.Style, Size: prop.Size}
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetLinesQuantity(value, &prop, width).Return(3)
+		provider.EXPECT().GetTextHeight(font).Return(5.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 3*5.0+2*prop.VerticalPadding+prop.Top, height)
+		provider.AssertNumberOfCalls(t, "GetLinesQuantity", 1)
+		provider.AssertNumberOfCalls(t, "GetTextHeight", 1)
+	})
+}
+
+func TestText_Split(t *testing.T) {
+	// countLines simulates two words per line.
+	countLines := func(value string, _ *props.Text, _ float64) int {
+		return (len(strings.Fields(value)) + 1) / 2
+	}
+
+	t.Run("when text fits in height, should not split", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := text.New("a b c d", props.Text{Size: 10})
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{}})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(5.0)
+		provider.EXPECT().GetLinesQuantity(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(countLines)
+
+		// Act
+		first, rest := sut.(core.Splittable).Split(provider, &cell, 10)
+
+		// Assert
+		assert.Equal(t, sut, first)
+		assert.Nil(t, rest)
+	})
+	t.Run("when no line fits in height, should move the whole text to the rest", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := text.New("a b c d", props.Text{Size: 10, Top: 3})
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{}})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(5.0)
+
+		// Act
+		first, rest := sut.(core.Splittable).Split(provider, &cell, 7)
+
+		// Assert
+		assert.Nil(t, first)
+		assert.Equal(t, sut, rest)
+	})
+	t.Run("when text does not fit in height, should split the lines", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := text.New("a b c d e", props.Text{Size: 10, Top: 2})
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{}})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(5.0)
+		provider.EXPECT().GetLinesQuantity(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(countLines)
+
+		// Act
+		first, rest := sut.(core.Splittable).Split(provider, &cell, 12)
+
+		// Assert
+		assert.Equal(t, "a b c d", first.GetStructure().GetData().Value)
+		assert.Equal(t, 2.0, first.GetStructure().GetData().Details["prop_top"])
+		assert.Equal(t, "e", rest.GetStructure().GetData().Value)
+		assert.Nil(t, rest.GetStructure().GetData().Details["prop_top"])
+	})
+	t.Run("when break line strategy is dash, should split the characters", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := text.New("abcdef", props.Text{Size: 10, BreakLineStrategy: breakline.DashStrategy})
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{}})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(5.0)
+		provider.EXPECT().GetLinesQuantity(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(value string, _ *props.Text, _ float64) int {
+				return (len(value) + 3) / 4
+			})
+
+		// Act
+		first, rest := sut.(core.Splittable).Split(provider, &cell, 5)
+
+		// Assert
+		assert.Equal(t, "abcd", first.GetStructure().GetData().Value)
+		assert.Equal(t, "ef", rest.GetStructure().GetData().Value)
+	})
+}
+
 func TestText_Render(t *testing.T) {
 	t.Run("should call provider correctly", func(t *testing.T) {
 		// Arrange
@@ -0,0 +1,127 @@
+package toc
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+type entry struct {
+	anchor entity.Anchor
+	prop   props.Text
+	indent float64
+	leader string
+	config *entity.Config
+}
+
+func newEntry(anchor entity.Anchor, prop props.TOC) core.Component {
+	return &entry{
+		anchor: anchor,
+		prop:   prop.EntryProp,
+		indent: prop.Indent,
+		leader: prop.Leader,
+	}
+}
+
+// GetStructure returns the Structure of an entry.
+func (e *entry) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "toc_entry",
+		Value:   e.anchor.Title,
+		Details: e.anchor.AppendMap(e.prop.ToMap()),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (e *entry) SetConfig(config *entity.Config) {
+	e.config = config
+	e.prop.MakeValid(e.config.DefaultFont)
+}
+
+// GetHeight returns the height of an entry, where the title is
+// wrapped in the lines between the indent and the page number.
+func (e *entry) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	titleProp := e.getTitleProp(provider)
+	width := cell.Width - titleProp.Left - titleProp.Right
+	amountLines := provider.GetLinesQuantity(e.anchor.Title, &titleProp, width)
+
+	textHeight := float64(amountLines)*e.getFontHeight(provider) + float64(amountLines-1)*e.prop.VerticalPadding
+	return textHeight + e.prop.Top
+}
+
+// Render renders the title of an entry on the left, its page number on the
+// right of the last line of the title and fills the space between them with
+// the leader.
+func (e *entry) Render(provider core.Provider, cell *entity.Cell) {
+	number := e.getPageNumber()
+	numberWidth := provider.GetStringWidth(number, &e.prop)
+	leaderWidth := provider.GetStringWidth(e.leader, &e.prop)
+
+	titleProp := e.getTitleProp(provider)
+	provider.AddText(e.anchor.Title, cell, &titleProp)
+
+	lines := provider.GetLines(e.anchor.Title, &titleProp, cell.Width-titleProp.Left-titleProp.Right)
+	lastLineWidth := provider.GetStringWidth(lines[len(lines)-1], &e.prop)
+	lastLineTop := float64(len(lines)-1) * (e.getFontHeight(provider) + e.prop.VerticalPadding)
+
+	numberProp := e.prop
+	numberProp.Align = align.Right
+	numberProp.Top += lastLineTop
+	provider.AddText(number, cell, &numberProp)
+
+	leaderProp := e.prop
+	leaderProp.Align = align.Right
+	leaderProp.Top += lastLineTop
+	leaderProp.Left = titleProp.Left + lastLineWidth + leaderWidth
+	leaderProp.Right += numberWidth + leaderWidth
+
+	space := cell.Width - leaderProp.Left - leaderProp.Right
+	if space <= 0 || leaderWidth <= 0 {
+		return
+	}
+
+	quantity := int(math.Ceil(space/leaderWidth)) - 1
+	provider.AddText(strings.Repeat(e.leader, quantity), cell, &leaderProp)
+}
+
+// getTitleProp returns the props of the title, which is placed between the
+// indent of its level and the page number, with the space of one leader.
+func (e *entry) getTitleProp(provider core.Provider) props.Text {
+	numberWidth := provider.GetStringWidth(e.getPageNumber(), &e.prop)
+	leaderWidth := provider.GetStringWidth(e.leader, &e.prop)
+
+	titleProp := e.prop
+	titleProp.Align = align.Left
+	titleProp.Left += float64(e.anchor.Level) * e.indent
+	titleProp.Right += numberWidth + leaderWidth
+
+	return titleProp
+}
+
+// getFontHeight returns the height of a line of the entry.
+func (e *entry) getFontHeight(provider core.Provider) float64 {
+	return provider.GetTextHeight(&props.Font{
+		Family: e.prop.Family,
+		Style:  e.prop.Style,
+		Size:   e.prop.Size,
+	})
+}
+
+// getPageNumber returns the label of the page of the anchor in its
+// section, or the number of the page when the anchor has no label.
+func (e *entry) getPageNumber() string {
+	if e.anchor.Label != "" {
+		return e.anchor.Label
+	}
+
+	return strconv.Itoa(e.anchor.Page)
+}
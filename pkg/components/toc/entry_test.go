@@ -0,0 +1,179 @@
+package toc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/miguelbernadi/maroto/v2/internal/fixture"
+	"github.com/miguelbernadi/maroto/v2/mocks"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+func TestEntry_GetHeight(t *testing.T) {
+	t.Run("when title fits in one line, should return height of one line", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.TOC{EntryProp: props.Text{Top: 2}}
+		prop.MakeValid()
+		sut := newEntry(entity.Anchor{Title: "Chapter 1", Page: 2}, prop)
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{Family: "arial", Size: 10}})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetStringWidth("2", mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(".", mock.Anything).Return(1.0)
+		provider.EXPECT().GetLinesQuantity("Chapter 1", mock.Anything, 95.0).Return(1)
+		provider.EXPECT().GetTextHeight(&props.Font{Family: "arial", Size: 10}).Return(4.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 6.0, height)
+	})
+	t.Run("when title wraps, should return height of all lines measured without the page number", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.TOC{EntryProp: props.Text{Top: 2, VerticalPadding: 1}}
+		prop.MakeValid()
+		title := "A chapter with a title longer than the width of the table of contents"
+		sut := newEntry(entity.Anchor{Title: title, Level: 1, Page: 12, Label: "xii"}, prop)
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{Family: "arial", Size: 10}})
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetStringWidth("xii", mock.Anything).Return(6.0)
+		provider.EXPECT().GetStringWidth(".", mock.Anything).Return(1.0)
+		provider.EXPECT().GetLinesQuantity(title, mock.Anything, 88.0).Return(3)
+		provider.EXPECT().GetTextHeight(&props.Font{Family: "arial", Size: 10}).Return(4.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		// 3 lines of 4 + 2 vertical paddings of 1 + 2 of top.
+		assert.Equal(t, 16.0, height)
+	})
+}
+
+func TestEntry_Render(t *testing.T) {
+	t.Run("when there is space, should add title, page number and leader", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.TOC{}
+		prop.MakeValid()
+		sut := newEntry(entity.Anchor{Title: "Chapter 1", Level: 1, Page: 12}, prop)
+
+		var texts []string
+		var textProps []*props.Text
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetStringWidth("Chapter 1", mock.Anything).Return(20.0)
+		provider.EXPECT().GetStringWidth("12", mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(".", mock.Anything).Return(1.0)
+		provider.EXPECT().GetLines("Chapter 1", mock.Anything, 90.0).Return([]string{"Chapter 1"})
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText(mock.Anything, &cell, mock.Anything).Run(func(text string, _ *entity.Cell, prop *props.Text) {
+			texts = append(texts, text)
+			textProps = append(textProps, prop)
+		})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Len(t, texts, 3)
+		assert.Equal(t, "Chapter 1", texts[0])
+		assert.Equal(t, align.Left, textProps[0].Align)
+		assert.Equal(t, 5.0, textProps[0].Left)
+		assert.Equal(t, "12", texts[1])
+		assert.Equal(t, align.Right, textProps[1].Align)
+		// 100 of width - 5 of indent - 20 of title - 4 of number - 2 leaders of spacing.
+		assert.Len(t, texts[2], 68)
+		assert.Equal(t, align.Right, textProps[2].Align)
+	})
+	t.Run("when title wraps, should add page number and leader after the last line", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.TOC{}
+		prop.MakeValid()
+		title := "A chapter with a long title"
+		sut := newEntry(entity.Anchor{Title: title, Page: 12}, prop)
+
+		var texts []string
+		var textProps []*props.Text
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetStringWidth("12", mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(".", mock.Anything).Return(1.0)
+		provider.EXPECT().GetLines(title, mock.Anything, 95.0).Return([]string{"A chapter with a ", "long title "})
+		provider.EXPECT().GetStringWidth("long title ", mock.Anything).Return(30.0)
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText(mock.Anything, &cell, mock.Anything).Run(func(text string, _ *entity.Cell, prop *props.Text) {
+			texts = append(texts, text)
+			textProps = append(textProps, prop)
+		})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Len(t, texts, 3)
+		assert.Equal(t, 0.0, textProps[0].Top)
+		assert.Equal(t, "12", texts[1])
+		assert.Equal(t, 4.0, textProps[1].Top)
+		// 100 of width - 30 of the last line - 4 of number - 2 leaders of spacing.
+		assert.Len(t, texts[2], 63)
+		assert.Equal(t, 4.0, textProps[2].Top)
+		assert.Equal(t, 31.0, textProps[2].Left)
+	})
+	t.Run("when there is no space, should not add leader", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.TOC{}
+		prop.MakeValid()
+		sut := newEntry(entity.Anchor{Title: "Chapter 1", Page: 12}, prop)
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetStringWidth("Chapter 1", mock.Anything).Return(200.0)
+		provider.EXPECT().GetStringWidth("12", mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(".", mock.Anything).Return(1.0)
+		provider.EXPECT().GetLines("Chapter 1", mock.Anything, 95.0).Return([]string{"Chapter 1"})
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText(mock.Anything, &cell, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 2)
+	})
+	t.Run("when anchor has label, should add label as page number", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.TOC{}
+		prop.MakeValid()
+		sut := newEntry(entity.Anchor{Title: "Preface", Page: 3, Label: "iii"}, prop)
+
+		var texts []string
+
+		provider := &mocks.Provider{}
+		provider.EXPECT().GetStringWidth("Preface", mock.Anything).Return(20.0)
+		provider.EXPECT().GetStringWidth("iii", mock.Anything).Return(4.0)
+		provider.EXPECT().GetStringWidth(".", mock.Anything).Return(1.0)
+		provider.EXPECT().GetLines("Preface", mock.Anything, 95.0).Return([]string{"Preface"})
+		provider.EXPECT().GetTextHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText(mock.Anything, &cell, mock.Anything).Run(func(text string, _ *entity.Cell, _ *props.Text) {
+			texts = append(texts, text)
+		})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Len(t, texts, 3)
+		assert.Equal(t, "iii", texts[1])
+	})
+}
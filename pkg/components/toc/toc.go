@@ -0,0 +1,48 @@
+// Package toc implements creation of tables of contents.
+package toc
+
+import (
+	"github.com/miguelbernadi/maroto/v2/pkg/components/col"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/text"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+type TOC struct {
+	title string
+	prop  props.TOC
+}
+
+// New is responsible to create an instance of a TOC. The TOC lists every
+// row marked with row.WithAnchor, and must be added to the document with
+// core.Maroto.AddTOC. When the title is empty, no title row is created.
+func New(title string, ps ...props.TOC) core.TOC {
+	tocProp := props.TOC{}
+	if len(ps) > 0 {
+		tocProp = ps[0]
+	}
+	tocProp.MakeValid()
+
+	return &TOC{
+		title: title,
+		prop:  tocProp,
+	}
+}
+
+// GetRows returns the rows of a TOC, one to the title and one to each anchor.
+func (t *TOC) GetRows(anchors []entity.Anchor) []core.Row {
+	var rows []core.Row
+
+	if t.title != "" {
+		rows = append(rows, row.New().Add(col.New().Add(text.New(t.title, t.prop.TitleProp))))
+	}
+
+	for _, anchor := range anchors {
+		e := newEntry(anchor, t.prop)
+		rows = append(rows, row.New().Add(col.New().Add(e)))
+	}
+
+	return rows
+}
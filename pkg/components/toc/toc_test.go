@@ -0,0 +1,54 @@
+package toc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/components/page"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/toc"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+	"github.com/miguelbernadi/maroto/v2/pkg/test"
+)
+
+func TestNew(t *testing.T) {
+	// Act
+	sut := toc.New("Contents")
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*toc.TOC", fmt.Sprintf("%T", sut))
+}
+
+func TestTOC_GetRows(t *testing.T) {
+	anchors := []entity.Anchor{
+		{Title: "Chapter 1", Level: 0, Page: 2},
+		{Title: "Section 1.1", Level: 1, Page: 3},
+		{Title: "Chapter 2", Level: 0, Page: 5},
+	}
+
+	t.Run("when title is empty, should return only entries", func(t *testing.T) {
+		// Arrange
+		sut := toc.New("")
+
+		// Act
+		rows := sut.GetRows(anchors)
+
+		// Assert
+		assert.Len(t, rows, 3)
+	})
+	t.Run("when title is defined, should return title and entries", func(t *testing.T) {
+		// Arrange
+		sut := toc.New("Contents", props.TOC{Leader: "-"})
+
+		// Act
+		rows := sut.GetRows(anchors)
+		p := page.New().Add(rows...)
+
+		// Assert
+		assert.Len(t, rows, 4)
+		test.New(t).Assert(p.GetStructure()).Equals("components/toc/get_rows.json")
+	})
+}
@@ -24,9 +24,11 @@ type Builder interface {
 	WithPageSize(size pagesize.Type) Builder
 	WithDimensions(width float64, height float64) Builder
 	WithMargins(left float64, top float64, right float64) Builder
+	WithBottomMargin(bottom float64) Builder
 	WithWorkerPoolSize(poolSize int) Builder
 	WithDebug(on bool) Builder
 	WithMaxGridSize(maxGridSize int) Builder
+	WithGutter(gutter float64) Builder
 	WithDefaultFont(font *props.Font) Builder
 	WithPageNumber(pattern string, place props.Place) Builder
 	WithProtection(protectionType protection.Type, userPassword, ownerPassword string) Builder
@@ -39,7 +41,11 @@ type Builder interface {
 	WithCreationDate(time time.Time) Builder
 	WithCustomFonts([]*entity.CustomFont) Builder
 	WithBackgroundImage([]byte, extension.Type) Builder
+	WithTextWatermark(text string, ps ...props.Watermark) Builder
+	WithImageWatermark(bytes []byte, ext extension.Type, ps ...props.Watermark) Builder
 	WithDisableAutoPageBreak(disabled bool) Builder
+	WithStrictMode(on bool) Builder
+	WithProvider(providerType provider.Type) Builder
 	Build() *entity.Config
 }
 
@@ -50,6 +56,7 @@ type CfgBuilder struct {
 	workerPoolSize       int
 	debug                bool
 	maxGridSize          int
+	gutter               float64
 	defaultFont          *props.Font
 	customFonts          []*entity.CustomFont
 	pageNumberPattern    string
@@ -60,7 +67,9 @@ type CfgBuilder struct {
 	orientation          orientation.Type
 	metadata             *entity.Metadata
 	backgroundImage      *entity.Image
+	watermark            *entity.Watermark
 	disableAutoPageBreak bool
+	strictMode           bool
 }
 
 // NewBuilder is responsible to create an instance of Builder.
@@ -108,7 +117,7 @@ func (b *CfgBuilder) WithDimensions(width float64, height float64) Builder {
 	return b
 }
 
-// WithMargins defines custom margins, bottom margin is not customizable due to gofpdf limitations.
+// WithMargins defines custom left, top and right margins, the bottom margin is defined by WithBottomMargin.
 func (b *CfgBuilder) WithMargins(left float64, top float64, right float64) Builder {
 	if left < pagesize.MinLeftMargin {
 		return b
@@ -129,6 +138,18 @@ func (b *CfgBuilder) WithMargins(left float64, top float64, right float64) Build
 	return b
 }
 
+// WithBottomMargin defines a custom bottom margin, which is the space
+// under the footer, where no row is added. Unlike the margins defined by
+// WithMargins, it can be smaller than the default bottom margin.
+func (b *CfgBuilder) WithBottomMargin(bottom float64) Builder {
+	if bottom < pagesize.MinBottomMargin {
+		return b
+	}
+
+	b.margins.Bottom = bottom
+	return b
+}
+
 // WithWorkerPoolSize defines go routine workers, when defined this will execute maroto concurrently.
 func (b *CfgBuilder) WithWorkerPoolSize(poolSize int) Builder {
 	if poolSize < 0 {
@@ -155,6 +176,16 @@ func (b *CfgBuilder) WithMaxGridSize(maxGridSize int) Builder {
 	return b
 }
 
+// WithGutter defines the space between two columns of a row.
+func (b *CfgBuilder) WithGutter(gutter float64) Builder {
+	if gutter < 0 {
+		return b
+	}
+
+	b.gutter = gutter
+	return b
+}
+
 // WithDefaultFont defines a custom font, other than arial. This can be used to define a custom font as default.
 func (b *CfgBuilder) WithDefaultFont(font *props.Font) Builder {
 	if font == nil {
@@ -190,9 +221,16 @@ func (b *CfgBuilder) WithCustomFonts(customFonts []*entity.CustomFont) Builder {
 	return b
 }
 
-// WithPageNumber defines a string pattern to write the current page and total.
+// WithPageNumber defines a string pattern to write the current page and total,
+// or the number of the page in its section and the total of the section.
 func (b *CfgBuilder) WithPageNumber(pattern string, place props.Place) Builder {
-	if !strings.Contains(pattern, "{current}") && !strings.Contains(pattern, "{total}") {
+	placeholders := []string{"{current}", "{total}", "{section}", "{section_total}"}
+	hasPlaceholder := false
+	for _, placeholder := range placeholders {
+		hasPlaceholder = hasPlaceholder || strings.Contains(pattern, placeholder)
+	}
+
+	if !hasPlaceholder {
 		return b
 	}
 
@@ -307,12 +345,70 @@ func (b *CfgBuilder) WithBackgroundImage(bytes []byte, ext extension.Type) Build
 	return b
 }
 
+// WithTextWatermark defines a text watermark, e.g. "DRAFT", that will be drawn in the pages.
+// By default it is drawn below the content of every page, with the default font.
+func (b *CfgBuilder) WithTextWatermark(text string, ps ...props.Watermark) Builder {
+	if text == "" {
+		return b
+	}
+
+	b.watermark = &entity.Watermark{
+		Text: text,
+		Prop: getWatermarkProp(ps...),
+	}
+
+	return b
+}
+
+// WithImageWatermark defines an image watermark that will be drawn in the pages.
+// By default it is drawn below the content of every page.
+func (b *CfgBuilder) WithImageWatermark(bytes []byte, ext extension.Type, ps ...props.Watermark) Builder {
+	if len(bytes) == 0 {
+		return b
+	}
+
+	b.watermark = &entity.Watermark{
+		Image: &entity.Image{
+			Bytes:     bytes,
+			Extension: ext,
+		},
+		Prop: getWatermarkProp(ps...),
+	}
+
+	return b
+}
+
 // WithDisableAutoPageBreak defines the option to disable automatic page breaks.
+//
+// Deprecated: pages are broken by maroto, as rows are paginated before the
+// document is generated, and the automatic page breaks of providers are always
+// disabled, so this option has no effect.
 func (b *CfgBuilder) WithDisableAutoPageBreak(disabled bool) Builder {
 	b.disableAutoPageBreak = disabled
 	return b
 }
 
+// WithStrictMode defines the option to return the failures of components,
+// e.g. a corrupted image, as errors of Generate instead of adding an error
+// text in the document.
+func (b *CfgBuilder) WithStrictMode(on bool) Builder {
+	b.strictMode = on
+	return b
+}
+
+// WithProvider defines the provider which generates the document. The default
+// provider is gofpdf, which generates a PDF. The html provider generates an HTML
+// document with one SVG per page, which can be used as a preview of the PDF, and
+// the png provider generates a ZIP archive with one PNG image per page.
+func (b *CfgBuilder) WithProvider(providerType provider.Type) Builder {
+	if !providerType.IsValid() {
+		return b
+	}
+
+	b.providerType = providerType
+	return b
+}
+
 // Build finalizes the customization returning the entity.Config.
 func (b *CfgBuilder) Build() *entity.Config {
 	return &entity.Config{
@@ -322,6 +418,7 @@ func (b *CfgBuilder) Build() *entity.Config {
 		WorkersQuantity:      b.workerPoolSize,
 		Debug:                b.debug,
 		MaxGridSize:          b.maxGridSize,
+		Gutter:               b.gutter,
 		DefaultFont:          b.defaultFont,
 		PageNumberPattern:    b.pageNumberPattern,
 		PageNumberPlace:      b.pageNumberPlace,
@@ -330,8 +427,28 @@ func (b *CfgBuilder) Build() *entity.Config {
 		Metadata:             b.metadata,
 		CustomFonts:          b.customFonts,
 		BackgroundImage:      b.backgroundImage,
+		Watermark:            b.getWatermark(),
 		DisableAutoPageBreak: b.disableAutoPageBreak,
+		StrictMode:           b.strictMode,
+	}
+}
+
+func (b *CfgBuilder) getWatermark() *entity.Watermark {
+	if b.watermark == nil {
+		return nil
+	}
+
+	b.watermark.Prop.MakeValid(b.defaultFont)
+	return b.watermark
+}
+
+func getWatermarkProp(ps ...props.Watermark) props.Watermark {
+	prop := props.Watermark{}
+	if len(ps) > 0 {
+		prop = ps[0]
 	}
+
+	return prop
 }
 
 func (b *CfgBuilder) getDimensions() *entity.Dimensions {
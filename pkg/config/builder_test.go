@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/miguelbernadi/maroto/v2/pkg/config"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontfamily"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontstyle"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/orientation"
@@ -274,6 +275,39 @@ func TestBuilder_WithMaxGridSize(t *testing.T) {
 	})
 }
 
+func TestBuilder_WithGutter(t *testing.T) {
+	t.Run("when gutter is not set, should be 0", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.Build()
+
+		// Assert
+		assert.Equal(t, 0.0, cfg.Gutter)
+	})
+	t.Run("when gutter is negative, should not change the default value", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithGutter(-2).Build()
+
+		// Assert
+		assert.Equal(t, 0.0, cfg.Gutter)
+	})
+	t.Run("when gutter is valid, should apply it", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithGutter(2).Build()
+
+		// Assert
+		assert.Equal(t, 2.0, cfg.Gutter)
+	})
+}
+
 func TestBuilder_WithMargins(t *testing.T) {
 	t.Run("when margins has invalid left, should not change the default value", func(t *testing.T) {
 		// Arrange
@@ -328,6 +362,39 @@ func TestBuilder_WithMargins(t *testing.T) {
 	})
 }
 
+func TestBuilder_WithBottomMargin(t *testing.T) {
+	t.Run("when bottom margin is not defined, should use default value", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.Build()
+
+		// Assert
+		assert.Equal(t, pagesize.DefaultBottomMargin, cfg.Margins.Bottom)
+	})
+	t.Run("when bottom margin is invalid, should not change the default value", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithBottomMargin(-1).Build()
+
+		// Assert
+		assert.Equal(t, pagesize.DefaultBottomMargin, cfg.Margins.Bottom)
+	})
+	t.Run("when bottom margin is valid, should change the default value", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithBottomMargin(5).Build()
+
+		// Assert
+		assert.Equal(t, 5.0, cfg.Margins.Bottom)
+	})
+}
+
 func TestBuilder_WithOrientation(t *testing.T) {
 	t.Run("when using default page size and orientation is not set, should use vertical", func(t *testing.T) {
 		// Arrange
@@ -499,6 +566,85 @@ func TestBuilder_WithCreationDate(t *testing.T) {
 	})
 }
 
+func TestBuilder_WithTextWatermark(t *testing.T) {
+	t.Run("when text is empty, should not set a watermark", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithTextWatermark("").Build()
+
+		// Assert
+		assert.Nil(t, cfg.Watermark)
+	})
+	t.Run("when prop is not sent, should use the default props and font", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithTextWatermark("DRAFT").Build()
+
+		// Assert
+		assert.Equal(t, "DRAFT", cfg.Watermark.Text)
+		assert.Nil(t, cfg.Watermark.Image)
+		assert.Equal(t, props.DefaultWatermarkOpacity, cfg.Watermark.Prop.Opacity)
+		assert.Equal(t, props.DefaultWatermarkSize, cfg.Watermark.Prop.Size)
+		assert.Equal(t, fontfamily.Arial, cfg.Watermark.Prop.Family)
+		assert.Equal(t, fontstyle.Normal, cfg.Watermark.Prop.Style)
+	})
+	t.Run("when prop is sent, should use the prop", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+		prop := props.Watermark{
+			Angle:   45,
+			Opacity: 0.5,
+			Family:  fontfamily.Courier,
+			Style:   fontstyle.Bold,
+			Size:    50,
+			Color:   &props.RedColor,
+			Pages:   []int{1},
+		}
+
+		// Act
+		cfg := sut.WithTextWatermark("DRAFT", prop).Build()
+
+		// Assert
+		assert.Equal(t, 45.0, cfg.Watermark.Prop.Angle)
+		assert.Equal(t, 0.5, cfg.Watermark.Prop.Opacity)
+		assert.Equal(t, fontfamily.Courier, cfg.Watermark.Prop.Family)
+		assert.Equal(t, fontstyle.Bold, cfg.Watermark.Prop.Style)
+		assert.Equal(t, 50.0, cfg.Watermark.Prop.Size)
+		assert.Equal(t, &props.RedColor, cfg.Watermark.Prop.Color)
+		assert.Equal(t, []int{1}, cfg.Watermark.Prop.Pages)
+	})
+}
+
+func TestBuilder_WithImageWatermark(t *testing.T) {
+	t.Run("when bytes are empty, should not set a watermark", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithImageWatermark(nil, extension.Png).Build()
+
+		// Assert
+		assert.Nil(t, cfg.Watermark)
+	})
+	t.Run("when bytes are sent, should set the image watermark", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithImageWatermark([]byte{1, 2, 3}, extension.Png, props.Watermark{Percent: 50}).Build()
+
+		// Assert
+		assert.Equal(t, []byte{1, 2, 3}, cfg.Watermark.Image.Bytes)
+		assert.Equal(t, extension.Png, cfg.Watermark.Image.Extension)
+		assert.Equal(t, 50.0, cfg.Watermark.Prop.Percent)
+		assert.Equal(t, props.DefaultWatermarkOpacity, cfg.Watermark.Prop.Opacity)
+	})
+}
+
 func TestBuilder_WithDisableAutoPageBreak(t *testing.T) {
 	t.Run("when disable auto page break is false, should not change the default value", func(t *testing.T) {
 		// Arrange
@@ -521,3 +667,93 @@ func TestBuilder_WithDisableAutoPageBreak(t *testing.T) {
 		assert.Equal(t, true, cfg.DisableAutoPageBreak)
 	})
 }
+
+func TestBuilder_WithPageNumber(t *testing.T) {
+	t.Run("when pattern has no placeholder, should not set page number", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithPageNumber("page", props.Bottom).Build()
+
+		// Assert
+		assert.Equal(t, "", cfg.PageNumberPattern)
+	})
+	t.Run("when place is not valid, should not set page number", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithPageNumber("{current}", props.Place("invalid")).Build()
+
+		// Assert
+		assert.Equal(t, "", cfg.PageNumberPattern)
+	})
+	t.Run("when pattern has section placeholder, should set page number", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithPageNumber("{section} of {section_total}", props.Bottom).Build()
+
+		// Assert
+		assert.Equal(t, "{section} of {section_total}", cfg.PageNumberPattern)
+		assert.Equal(t, props.Bottom, cfg.PageNumberPlace)
+	})
+}
+
+func TestBuilder_WithStrictMode(t *testing.T) {
+	t.Run("when strict mode is not set, should be disabled", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.Build()
+
+		// Assert
+		assert.Equal(t, false, cfg.StrictMode)
+	})
+	t.Run("when strict mode is true, should enable it", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithStrictMode(true).Build()
+
+		// Assert
+		assert.Equal(t, true, cfg.StrictMode)
+	})
+}
+
+func TestBuilder_WithProvider(t *testing.T) {
+	t.Run("when provider is not set, should use gofpdf", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.Build()
+
+		// Assert
+		assert.Equal(t, provider.Gofpdf, cfg.ProviderType)
+	})
+	t.Run("when provider is invalid, should not change the default value", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithProvider("invalid").Build()
+
+		// Assert
+		assert.Equal(t, provider.Gofpdf, cfg.ProviderType)
+	})
+	t.Run("when provider is html, should change the provider", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithProvider(provider.HTML).Build()
+
+		// Assert
+		assert.Equal(t, provider.HTML, cfg.ProviderType)
+	})
+}
@@ -0,0 +1,87 @@
+// Package numbering contains all styles of page numbers.
+package numbering
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Type is a representation of the style of page numbers.
+type Type string
+
+const (
+	// Decimal represents arabic numbers, e.g. 1, 2, 3.
+	Decimal Type = "decimal"
+	// LowerRoman represents lowercase roman numerals, e.g. i, ii, iii.
+	LowerRoman Type = "lower_roman"
+	// UpperRoman represents uppercase roman numerals, e.g. I, II, III.
+	UpperRoman Type = "upper_roman"
+	// LowerLetter represents lowercase letters, e.g. a, b, ..., z, aa, bb.
+	LowerLetter Type = "lower_letter"
+	// UpperLetter represents uppercase letters, e.g. A, B, ..., Z, AA, BB.
+	UpperLetter Type = "upper_letter"
+)
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "m"},
+	{900, "cm"},
+	{500, "d"},
+	{400, "cd"},
+	{100, "c"},
+	{90, "xc"},
+	{50, "l"},
+	{40, "xl"},
+	{10, "x"},
+	{9, "ix"},
+	{5, "v"},
+	{4, "iv"},
+	{1, "i"},
+}
+
+// IsValid checks if the numbering is valid.
+func (t Type) IsValid() bool {
+	return t == Decimal || t == LowerRoman || t == UpperRoman || t == LowerLetter || t == UpperLetter
+}
+
+// Format returns the number written with the numbering, which is decimal when
+// the numbering is not valid. Numbers lower than 1 are only written as decimal.
+func (t Type) Format(number int) string {
+	if number < 1 {
+		return strconv.Itoa(number)
+	}
+
+	switch t {
+	case LowerRoman:
+		return toRoman(number)
+	case UpperRoman:
+		return strings.ToUpper(toRoman(number))
+	case LowerLetter:
+		return toLetter(number)
+	case UpperLetter:
+		return strings.ToUpper(toLetter(number))
+	default:
+		return strconv.Itoa(number)
+	}
+}
+
+func toRoman(number int) string {
+	var sb strings.Builder
+	for _, numeral := range romanNumerals {
+		for number >= numeral.value {
+			sb.WriteString(numeral.symbol)
+			number -= numeral.value
+		}
+	}
+
+	return sb.String()
+}
+
+// toLetter writes the number as the letters of the pages labels of PDF,
+// where the letter is repeated after z, e.g. 27 is aa.
+func toLetter(number int) string {
+	letter := string(rune('a' + (number-1)%26))
+	return strings.Repeat(letter, (number-1)/26+1)
+}
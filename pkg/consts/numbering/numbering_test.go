@@ -0,0 +1,57 @@
+package numbering_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when type is empty, should not be valid", func(t *testing.T) {
+		// Arrange
+		n := numbering.Type("")
+
+		// Act & Assert
+		assert.False(t, n.IsValid())
+	})
+	t.Run("when type is a numbering, should be valid", func(t *testing.T) {
+		// Arrange
+		types := []numbering.Type{
+			numbering.Decimal, numbering.LowerRoman, numbering.UpperRoman, numbering.LowerLetter, numbering.UpperLetter,
+		}
+
+		// Act & Assert
+		for _, n := range types {
+			assert.True(t, n.IsValid())
+		}
+	})
+}
+
+func TestType_Format(t *testing.T) {
+	t.Run("when type is decimal, should write arabic numbers", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "12", numbering.Decimal.Format(12))
+	})
+	t.Run("when type is not valid, should write arabic numbers", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "12", numbering.Type("").Format(12))
+	})
+	t.Run("when type is roman, should write roman numerals", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "iv", numbering.LowerRoman.Format(4))
+		assert.Equal(t, "xiv", numbering.LowerRoman.Format(14))
+		assert.Equal(t, "MCMXCIX", numbering.UpperRoman.Format(1999))
+	})
+	t.Run("when type is letter, should repeat the letter after z", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "c", numbering.LowerLetter.Format(3))
+		assert.Equal(t, "AA", numbering.UpperLetter.Format(27))
+		assert.Equal(t, "bbb", numbering.LowerLetter.Format(54))
+	})
+	t.Run("when number is lower than 1, should write arabic numbers", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "0", numbering.UpperRoman.Format(0))
+	})
+}
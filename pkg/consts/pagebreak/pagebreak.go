@@ -0,0 +1,18 @@
+// Package pagebreak contains all types of page breaks.
+package pagebreak
+
+// Type is a representation of a page break.
+type Type string
+
+const (
+	// Next represents a break to the next page.
+	Next Type = "next"
+	// Odd represents a break to the next odd page, for duplex printing,
+	// where an empty page is added when the next page is even.
+	Odd Type = "odd"
+)
+
+// IsValid checks if the page break is valid.
+func (t Type) IsValid() bool {
+	return t == Next || t == Odd
+}
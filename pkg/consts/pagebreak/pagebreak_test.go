@@ -0,0 +1,24 @@
+package pagebreak_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagebreak"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when type is empty, should not be valid", func(t *testing.T) {
+		// Arrange
+		breakType := pagebreak.Type("")
+
+		// Act & Assert
+		assert.False(t, breakType.IsValid())
+	})
+	t.Run("when type is a page break, should be valid", func(t *testing.T) {
+		// Act & Assert
+		assert.True(t, pagebreak.Next.IsValid())
+		assert.True(t, pagebreak.Odd.IsValid())
+	})
+}
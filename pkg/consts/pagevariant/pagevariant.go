@@ -0,0 +1,23 @@
+// Package pagevariant contains all variants of headers and footers.
+package pagevariant
+
+// Type is a representation of the pages where a header or a footer is used.
+type Type string
+
+const (
+	// Default represents every page without a more specific variant.
+	Default Type = "default"
+	// First represents the first page of the document.
+	First Type = "first"
+	// Odd represents the pages with odd numbers.
+	Odd Type = "odd"
+	// Even represents the pages with even numbers.
+	Even Type = "even"
+	// Last represents the last page of the document.
+	Last Type = "last"
+)
+
+// IsValid checks if the page variant is valid.
+func (t Type) IsValid() bool {
+	return t == Default || t == First || t == Odd || t == Even || t == Last
+}
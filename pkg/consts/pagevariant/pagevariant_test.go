@@ -0,0 +1,30 @@
+package pagevariant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagevariant"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when type is empty, should not be valid", func(t *testing.T) {
+		// Arrange
+		variant := pagevariant.Type("")
+
+		// Act & Assert
+		assert.False(t, variant.IsValid())
+	})
+	t.Run("when type is a variant, should be valid", func(t *testing.T) {
+		// Arrange
+		variants := []pagevariant.Type{
+			pagevariant.Default, pagevariant.First, pagevariant.Odd, pagevariant.Even, pagevariant.Last,
+		}
+
+		// Act & Assert
+		for _, variant := range variants {
+			assert.True(t, variant.IsValid())
+		}
+	})
+}
@@ -7,4 +7,13 @@ type Type string
 const (
 	// Gofpdf represents the gofpdf provider.
 	Gofpdf Type = "gofpdf"
+	// HTML represents the html provider, which draws each page as a SVG.
+	HTML Type = "html"
+	// PNG represents the png provider, which generates a ZIP archive with one PNG image per page.
+	PNG Type = "png"
 )
+
+// IsValid checks if the provider is valid.
+func (t Type) IsValid() bool {
+	return t == Gofpdf || t == HTML || t == PNG
+}
@@ -0,0 +1,39 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when type is empty, should not be valid", func(t *testing.T) {
+		// Act
+		providerType := provider.Type("")
+
+		// Act & Assert
+		assert.False(t, providerType.IsValid())
+	})
+	t.Run("when type is gofpdf, should be valid", func(t *testing.T) {
+		// Act
+		providerType := provider.Gofpdf
+
+		// Act & Assert
+		assert.True(t, providerType.IsValid())
+	})
+	t.Run("when type is html, should be valid", func(t *testing.T) {
+		// Act
+		providerType := provider.HTML
+
+		// Act & Assert
+		assert.True(t, providerType.IsValid())
+	})
+	t.Run("when type is png, should be valid", func(t *testing.T) {
+		// Act
+		providerType := provider.PNG
+
+		// Act & Assert
+		assert.True(t, providerType.IsValid())
+	})
+}
@@ -29,10 +29,19 @@ type Line interface {
 	Add(cell *entity.Cell, prop *props.Line)
 }
 
+// Link is the abstraction which deals of how to add links between positions of a PDF.
+type Link interface {
+	Add(name string, x, y, width, height float64)
+	SetDestination(name string)
+	SetMissingDestinations()
+}
+
 // Text is the abstraction which deals of how to add text inside PDF.
 type Text interface {
 	Add(text string, cell *entity.Cell, textProp *props.Text)
 	GetLinesQuantity(text string, fontFamily props.Text, colWidth float64) int
+	GetLines(text string, textProp *props.Text, colWidth float64) []string
+	GetStringWidth(text string, textProp *props.Text) float64
 }
 
 // Font is the abstraction which deals of how to set fontstyle configurations.
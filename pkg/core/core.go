@@ -2,8 +2,14 @@
 package core
 
 import (
+	"context"
+	"io"
+
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagebreak"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagevariant"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/miguelbernadi/maroto/v2/pkg/metrics"
 	"github.com/miguelbernadi/maroto/v2/pkg/props"
@@ -13,12 +19,23 @@ import (
 type Maroto interface {
 	RegisterHeader(rows ...Row) error
 	RegisterFooter(rows ...Row) error
+	RegisterHeaderVariant(variant pagevariant.Type, rows ...Row) error
+	RegisterFooterVariant(variant pagevariant.Type, rows ...Row) error
+	RegisterHeaderFunc(build func(ctx entity.PageContext) []Row) error
+	RegisterFooterFunc(build func(ctx entity.PageContext) []Row) error
 	AddRows(rows ...Row)
 	AddRow(rowHeight float64, cols ...Col) Row
+	AddGroup(rows ...Row)
 	FitlnCurrentPage(heightNewLine float64) bool
+	Cursor() entity.Cursor
 	AddPages(pages ...Page)
+	AddTOC(toc TOC) error
+	AddPageBreak(breakType ...pagebreak.Type)
+	StartSection(name string, numberingStyle numbering.Type) error
 	GetStructure() *node.Node[Structure]
 	Generate() (Document, error)
+	GenerateContext(ctx context.Context) (Document, error)
+	GenerateTo(w io.Writer) error
 }
 
 // Document is the interface that wraps the basic methods of a document.
@@ -39,24 +56,55 @@ type Node interface {
 // Component is the interface that wraps the basic methods of a component.
 type Component interface {
 	Node
+	GetHeight(provider Provider, cell *entity.Cell) float64
 	Render(provider Provider, cell *entity.Cell)
 }
 
+// Splittable is the interface implemented by components which can be split
+// in two components, to continue the content in the next page.
+type Splittable interface {
+	Split(provider Provider, cell *entity.Cell, height float64) (Component, Component)
+}
+
 // Col is the interface that wraps the basic methods of a col.
 type Col interface {
 	Node
 	Add(components ...Component) Col
+	AddRows(rows ...Row) Col
 	GetSize() int
+	GetHeight(provider Provider, cell *entity.Cell) float64
 	WithStyle(style *props.Cell) Col
+	WithStack(stack *props.Stack) Col
+	Split(provider Provider, cell *entity.Cell, height float64) (Col, Col, bool)
 	Render(provider Provider, cell entity.Cell, createCell bool)
 }
 
+// Measurable is the interface implemented by rows which can be created without
+// a height, to have the height measured from their content.
+type Measurable interface {
+	IsAutoHeight() bool
+	Measure(provider Provider, cell *entity.Cell) float64
+}
+
 // Row is the interface that wraps the basic methods of a row.
 type Row interface {
 	Node
 	Add(cols ...Col) Row
 	GetHeight() float64
 	WithStyle(style *props.Cell) Row
+	WithRepeatedHeader(rows ...Row) Row
+	GetRepeatedHeader() []Row
+	WithAnchor(title string, level int) Row
+	GetAnchor() *entity.Anchor
+	WithBookmark(title string, level int) Row
+	GetBookmark() *entity.Bookmark
+	WithDestination(name string) Row
+	GetDestination() string
+	KeepWithNext() Row
+	Split(provider Provider, cell *entity.Cell, height float64) (Row, Row)
+	IsKeptWithNext() bool
+	WithPageBreakBefore(breakType ...pagebreak.Type) Row
+	GetPageBreakBefore() pagebreak.Type
 	Render(provider Provider, cell entity.Cell)
 }
 
@@ -67,5 +115,12 @@ type Page interface {
 	GetRows() []Row
 	GetNumber() int
 	SetNumber(number int, total int)
+	SetSection(section entity.Section)
+	GetProp() props.Page
 	Render(provider Provider, cell entity.Cell)
 }
+
+// TOC is the interface that wraps the basic methods of a table of contents.
+type TOC interface {
+	GetRows(anchors []entity.Anchor) []Row
+}
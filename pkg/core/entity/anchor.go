@@ -0,0 +1,34 @@
+package entity
+
+// Anchor is the representation of a row marked as a heading of the document.
+type Anchor struct {
+	Title string
+	Level int
+	// Page is the number of the page where the row was placed, it is only
+	// known after the document is paginated.
+	Page int
+	// Label is the number of the page in its section, written with the
+	// numbering of the section, e.g. roman numerals in the front matter.
+	Label string
+}
+
+// AppendMap appends the anchor fields to a map.
+func (a *Anchor) AppendMap(m map[string]interface{}) map[string]interface{} {
+	if a.Title != "" {
+		m["anchor_title"] = a.Title
+	}
+
+	if a.Level != 0 {
+		m["anchor_level"] = a.Level
+	}
+
+	if a.Page != 0 {
+		m["anchor_page"] = a.Page
+	}
+
+	if a.Label != "" {
+		m["anchor_label"] = a.Label
+	}
+
+	return m
+}
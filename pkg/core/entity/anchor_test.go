@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnchor_AppendMap(t *testing.T) {
+	// Arrange
+	sut := fixtureAnchor()
+	m := make(map[string]interface{})
+
+	// Act
+	m = sut.AppendMap(m)
+
+	// Assert
+	assert.Equal(t, "title", m["anchor_title"])
+	assert.Equal(t, 1, m["anchor_level"])
+	assert.Equal(t, 3, m["anchor_page"])
+}
+
+func fixtureAnchor() Anchor {
+	return Anchor{
+		Title: "title",
+		Level: 1,
+		Page:  3,
+	}
+}
@@ -0,0 +1,20 @@
+package entity
+
+// Bookmark is the representation of an entry of the PDF outline.
+type Bookmark struct {
+	Title string
+	Level int
+}
+
+// AppendMap appends the bookmark fields to a map.
+func (b *Bookmark) AppendMap(m map[string]interface{}) map[string]interface{} {
+	if b.Title != "" {
+		m["bookmark_title"] = b.Title
+	}
+
+	if b.Level != 0 {
+		m["bookmark_level"] = b.Level
+	}
+
+	return m
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookmark_AppendMap(t *testing.T) {
+	// Arrange
+	sut := fixtureBookmark()
+	m := make(map[string]interface{})
+
+	// Act
+	m = sut.AppendMap(m)
+
+	// Assert
+	assert.Equal(t, "title", m["bookmark_title"])
+	assert.Equal(t, 1, m["bookmark_level"])
+}
+
+func fixtureBookmark() Bookmark {
+	return Bookmark{
+		Title: "title",
+		Level: 1,
+	}
+}
@@ -1,12 +1,23 @@
 // Package entity contains all core entities.
 package entity
 
+import (
+	"math"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
 // Cell represents a cell inside the PDF.
 type Cell struct {
 	X      float64
 	Y      float64
 	Width  float64
 	Height float64
+	// Path is the position of the cell in the document.
+	Path Path
+	// Page is the number of the page where the cell is rendered,
+	// which is nil while the rows are measured to be paginated.
+	Page *PageNumber
 }
 
 // GetDimensions returns the dimensions of the Cell (width and height).
@@ -21,9 +32,27 @@ func (c Cell) Copy() Cell {
 		Y:      c.Y,
 		Width:  c.Width,
 		Height: c.Height,
+		Path:   c.Path,
+		Page:   c.Page,
 	}
 }
 
+// ApplyPadding returns a copy of the cell reduced by the padding of the style,
+// which is the space available for the content of the cell.
+func (c Cell) ApplyPadding(style *props.Cell) Cell {
+	if style == nil {
+		return c.Copy()
+	}
+
+	inner := c.Copy()
+	inner.X += style.PaddingLeft
+	inner.Y += style.PaddingTop
+	inner.Width = math.Max(c.Width-style.PaddingLeft-style.PaddingRight, 0)
+	inner.Height = math.Max(c.Height-style.PaddingTop-style.PaddingBottom, 0)
+
+	return inner
+}
+
 // NewRootCell creates the main Cell.
 func NewRootCell(pageWidth, pageHeight float64, margins Margins) Cell {
 	return Cell{
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
 )
 
 func TestCell_GetDimensions(t *testing.T) {
@@ -33,6 +34,7 @@ func TestCell_Copy(t *testing.T) {
 			Y:      10,
 			Width:  100,
 			Height: 100,
+			Page:   &entity.PageNumber{Number: 2, Total: 5},
 		}
 
 		// Act
@@ -43,6 +45,7 @@ func TestCell_Copy(t *testing.T) {
 		assert.Equal(t, cell.Y, copyCell.Y)
 		assert.Equal(t, cell.Width, copyCell.Width)
 		assert.Equal(t, cell.Height, copyCell.Height)
+		assert.Equal(t, cell.Page, copyCell.Page)
 	})
 	t.Run("copy should not allow side-effects", func(t *testing.T) {
 		// Arrange
@@ -88,3 +91,39 @@ func TestNewRootContext(t *testing.T) {
 	assert.Equal(t, 80.0, cell.Width)
 	assert.Equal(t, 270.0, cell.Height)
 }
+
+func TestCell_ApplyPadding(t *testing.T) {
+	t.Run("when style is nil, should return the same cell", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 10, Width: 100, Height: 50}
+
+		// Act
+		inner := cell.ApplyPadding(nil)
+
+		// Assert
+		assert.Equal(t, cell, inner)
+	})
+	t.Run("when style has padding, should reduce the cell", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 10, Width: 100, Height: 50}
+		style := &props.Cell{PaddingLeft: 1, PaddingTop: 2, PaddingRight: 3, PaddingBottom: 4}
+
+		// Act
+		inner := cell.ApplyPadding(style)
+
+		// Assert
+		assert.Equal(t, entity.Cell{X: 11, Y: 12, Width: 96, Height: 44}, inner)
+	})
+	t.Run("when padding is greater than the cell, should not return negative sizes", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 10, Width: 10, Height: 10}
+		style := &props.Cell{PaddingLeft: 10, PaddingTop: 10, PaddingRight: 10, PaddingBottom: 10}
+
+		// Act
+		inner := cell.ApplyPadding(style)
+
+		// Assert
+		assert.Equal(t, 0.0, inner.Width)
+		assert.Equal(t, 0.0, inner.Height)
+	})
+}
@@ -15,13 +15,16 @@ type Config struct {
 	WorkersQuantity      int
 	Debug                bool
 	MaxGridSize          int
+	Gutter               float64
 	PageNumberPattern    string
 	PageNumberPlace      props.Place
 	Protection           *Protection
 	Compression          bool
 	Metadata             *Metadata
 	BackgroundImage      *Image
+	Watermark            *Watermark
 	DisableAutoPageBreak bool
+	StrictMode           bool
 }
 
 // ToMap converts Config to a map[string]interface{} .
@@ -56,6 +59,10 @@ func (c *Config) ToMap() map[string]interface{} {
 		m["config_max_grid_sum"] = c.MaxGridSize
 	}
 
+	if c.Gutter != 0 {
+		m["config_gutter"] = c.Gutter
+	}
+
 	if c.PageNumberPattern != "" {
 		m["config_page_number_pattern"] = c.PageNumberPattern
 	}
@@ -80,9 +87,17 @@ func (c *Config) ToMap() map[string]interface{} {
 		m = c.BackgroundImage.AppendMap(m)
 	}
 
+	if c.Watermark != nil {
+		m = c.Watermark.AppendMap(m)
+	}
+
 	if c.DisableAutoPageBreak {
 		m["config_disable_auto_page_break"] = c.DisableAutoPageBreak
 	}
 
+	if c.StrictMode {
+		m["config_strict_mode"] = c.StrictMode
+	}
+
 	return m
 }
@@ -35,6 +35,7 @@ func TestConfig_ToMap(t *testing.T) {
 	assert.Equal(t, 7, m["config_workers"])
 	assert.Equal(t, true, m["config_debug"])
 	assert.Equal(t, 15, m["config_max_grid_sum"])
+	assert.Equal(t, 2.0, m["config_gutter"])
 	assert.Equal(t, "pattern", m["config_page_number_pattern"])
 	assert.Equal(t, props.Bottom, m["config_page_number_place"])
 	assert.Equal(t, protection.Print, m["config_protection_type"])
@@ -51,6 +52,7 @@ func TestConfig_ToMap(t *testing.T) {
 	assert.Equal(t, 100.0, m["background_dimension_width"])
 	assert.Equal(t, 200.0, m["background_dimension_height"])
 	assert.Equal(t, true, m["config_disable_auto_page_break"])
+	assert.Equal(t, true, m["config_strict_mode"])
 }
 
 func fixtureConfig() Config {
@@ -69,6 +71,7 @@ func fixtureConfig() Config {
 		WorkersQuantity:      7,
 		Debug:                true,
 		MaxGridSize:          15,
+		Gutter:               2,
 		PageNumberPattern:    "pattern",
 		PageNumberPlace:      props.Bottom,
 		Protection:           &protection,
@@ -76,6 +79,7 @@ func fixtureConfig() Config {
 		Metadata:             &metadata,
 		BackgroundImage:      &image,
 		DisableAutoPageBreak: true,
+		StrictMode:           true,
 	}
 }
 
@@ -0,0 +1,14 @@
+package entity
+
+// Cursor is the representation of the position where the next row is added in a document.
+type Cursor struct {
+	// Page is the index of the current page, starting from 0.
+	Page int
+	// Pages is the quantity of pages so far, including the current page.
+	Pages int
+	// RemainingHeight is the height available for rows in the current page.
+	RemainingHeight float64
+	// UsefulArea is the area available for rows in the current page, which
+	// is the page without its margins, header and footer.
+	UsefulArea Dimensions
+}
@@ -0,0 +1,21 @@
+package entity
+
+// PageContext is the representation of a page given to the callbacks which
+// build its header and footer.
+type PageContext struct {
+	// Number is the number of the page, starting from 1.
+	Number int
+	// Total is the quantity of pages of the document, it is only known
+	// after the document is paginated, being 0 before it.
+	Total int
+	// Section is the name of the section of the page.
+	Section string
+	// Label is the number of the page in its section, written with
+	// the numbering of the section, e.g. iii.
+	Label string
+	// FirstRow and LastRow are the indexes of the first and the last rows
+	// added to the document which are placed in the page, counted from 0
+	// in the order they were added, being -1 when the page has no rows.
+	FirstRow int
+	LastRow  int
+}
@@ -0,0 +1,11 @@
+package entity
+
+// PageNumber is the number of a page in the document and in its section.
+type PageNumber struct {
+	// Number is the number of the page.
+	Number int
+	// Total is the quantity of pages of the document.
+	Total int
+	// Section is the section of the page, with the number of the page in it.
+	Section Section
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "fmt"
+
+// Path is the position of a component in the document.
+type Path struct {
+	// Page is the number of the page.
+	Page int
+	// Row is the index of the row inside the page.
+	Row int
+	// Col is the index of the col inside the row.
+	Col int
+}
+
+// String returns the Path in a human readable format.
+func (p Path) String() string {
+	return fmt.Sprintf("page %d, row %d, col %d", p.Page, p.Row, p.Col)
+}
@@ -0,0 +1,20 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+)
+
+func TestPath_String(t *testing.T) {
+	// Arrange
+	sut := entity.Path{Page: 2, Row: 3, Col: 1}
+
+	// Act
+	s := sut.String()
+
+	// Assert
+	assert.Equal(t, "page 2, row 3, col 1", s)
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+
+// Section is the representation of the section of a page, which has its own page numbering.
+type Section struct {
+	// Name is the name of the section.
+	Name string
+	// Numbering is the style of the page numbers of the section.
+	Numbering numbering.Type
+	// Number is the number of the page in the section, starting from 1.
+	Number int
+	// Total is the quantity of pages of the section.
+	Total int
+}
+
+// Label returns the number of the page in the section written with the numbering of the section.
+func (s Section) Label() string {
+	return s.Numbering.Format(s.Number)
+}
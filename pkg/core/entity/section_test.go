@@ -0,0 +1,33 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/numbering"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+)
+
+func TestSection_Label(t *testing.T) {
+	t.Run("when section has numbering, should write the number with it", func(t *testing.T) {
+		// Arrange
+		sut := entity.Section{Numbering: numbering.LowerRoman, Number: 3, Total: 4}
+
+		// Act
+		label := sut.Label()
+
+		// Assert
+		assert.Equal(t, "iii", label)
+	})
+	t.Run("when section has no numbering, should write the number as decimal", func(t *testing.T) {
+		// Arrange
+		sut := entity.Section{Number: 3, Total: 4}
+
+		// Act
+		label := sut.Label()
+
+		// Assert
+		assert.Equal(t, "3", label)
+	})
+}
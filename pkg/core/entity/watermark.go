@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+// Watermark is the representation of a text or an image drawn on the pages.
+// When both are defined, the image is drawn.
+type Watermark struct {
+	Text  string
+	Image *Image
+	Prop  props.Watermark
+}
+
+// AppendMap adds the Watermark fields to the map.
+func (w *Watermark) AppendMap(m map[string]interface{}) map[string]interface{} {
+	if w.Text != "" {
+		m["config_watermark_text"] = w.Text
+	}
+
+	if w.Image != nil {
+		m["config_watermark_image_extension"] = w.Image.Extension
+	}
+
+	for key, value := range w.Prop.ToMap() {
+		m["config_watermark_"+key] = value
+	}
+
+	return m
+}
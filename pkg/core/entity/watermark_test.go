@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+func TestWatermark_AppendMap(t *testing.T) {
+	t.Run("when watermark is a text, should append the text and the props", func(t *testing.T) {
+		// Arrange
+		sut := Watermark{
+			Text: "DRAFT",
+			Prop: props.Watermark{Angle: 45, Opacity: 0.3},
+		}
+		m := make(map[string]interface{})
+
+		// Act
+		m = sut.AppendMap(m)
+
+		// Assert
+		assert.Equal(t, "DRAFT", m["config_watermark_text"])
+		assert.Equal(t, 45.0, m["config_watermark_prop_angle"])
+		assert.Equal(t, 0.3, m["config_watermark_prop_opacity"])
+		assert.Nil(t, m["config_watermark_image_extension"])
+	})
+	t.Run("when watermark is an image, should append the extension", func(t *testing.T) {
+		// Arrange
+		sut := Watermark{
+			Image: &Image{Bytes: []byte{1, 2, 3}, Extension: extension.Png},
+		}
+		m := make(map[string]interface{})
+
+		// Act
+		m = sut.AppendMap(m)
+
+		// Assert
+		assert.Equal(t, extension.Png, m["config_watermark_image_extension"])
+		assert.Nil(t, m["config_watermark_text"])
+	})
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+)
+
+// ComponentError is the failure of a component that could not be added to
+// the document, e.g. a corrupted image. It is only returned in strict mode.
+type ComponentError struct {
+	Path    entity.Path
+	Message string
+	Err     error
+}
+
+// Error returns the message of the error with the path of the component.
+func (e *ComponentError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%s: %s", e.Path, e.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", e.Path, e.Message, e.Err)
+}
+
+// Unwrap returns the cause of the error.
+func (e *ComponentError) Unwrap() error {
+	return e.Err
+}
+
+// ComponentErrors is the set of failures of components of a document.
+type ComponentErrors []*ComponentError
+
+// Error returns the messages of all errors, one per line.
+func (e ComponentErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns all errors, so errors.Is and errors.As check each one of them.
+func (e ComponentErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+}
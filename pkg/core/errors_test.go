@@ -0,0 +1,73 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+)
+
+func TestComponentError_Error(t *testing.T) {
+	t.Run("when there is a cause, should return message with cause", func(t *testing.T) {
+		// Arrange
+		sut := &core.ComponentError{
+			Path:    entity.Path{Page: 1, Row: 2, Col: 0},
+			Message: "could not load image",
+			Err:     errors.New("file not found"),
+		}
+
+		// Act
+		message := sut.Error()
+
+		// Assert
+		assert.Equal(t, "page 1, row 2, col 0: could not load image: file not found", message)
+	})
+	t.Run("when there is no cause, should return message", func(t *testing.T) {
+		// Arrange
+		sut := &core.ComponentError{
+			Path:    entity.Path{Page: 1, Row: 2, Col: 0},
+			Message: "could not load image",
+		}
+
+		// Act
+		message := sut.Error()
+
+		// Assert
+		assert.Equal(t, "page 1, row 2, col 0: could not load image", message)
+	})
+}
+
+func TestComponentErrors_Error(t *testing.T) {
+	// Arrange
+	sut := core.ComponentErrors{
+		{Path: entity.Path{Page: 1}, Message: "could not load image"},
+		{Path: entity.Path{Page: 2}, Message: "could not generate qrcode"},
+	}
+
+	// Act
+	message := sut.Error()
+
+	// Assert
+	assert.Equal(t, "page 1, row 0, col 0: could not load image\npage 2, row 0, col 0: could not generate qrcode", message)
+}
+
+func TestComponentErrors_Unwrap(t *testing.T) {
+	// Arrange
+	cause := errors.New("file not found")
+	var err error = core.ComponentErrors{
+		{Path: entity.Path{Page: 1}, Message: "could not generate qrcode"},
+		{Path: entity.Path{Page: 2}, Message: "could not load image", Err: cause},
+	}
+
+	// Act
+	var componentErr *core.ComponentError
+	isComponentErr := errors.As(err, &componentErr)
+
+	// Assert
+	assert.True(t, isComponentErr)
+	assert.Equal(t, 1, componentErr.Path.Page)
+	assert.ErrorIs(t, err, cause)
+}
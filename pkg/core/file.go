@@ -0,0 +1,52 @@
+package core
+
+import (
+	"encoding/base64"
+	"errors"
+	"os"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/metrics"
+)
+
+// ErrMergeNotSupported is returned when a document which is not a PDF is merged.
+var ErrMergeNotSupported = errors.New("merge is only supported by pdf documents")
+
+// File is a document which is not a PDF, e.g. an HTML page or
+// a ZIP archive with the images of the pages.
+type File struct {
+	bytes  []byte
+	report *metrics.Report
+}
+
+// NewFile is responsible to create a new instance of a document which is not a PDF.
+func NewFile(bytes []byte, report *metrics.Report) Document {
+	return &File{
+		bytes:  bytes,
+		report: report,
+	}
+}
+
+// GetBytes returns the file bytes.
+func (f *File) GetBytes() []byte {
+	return f.bytes
+}
+
+// GetBase64 returns the file bytes in base64.
+func (f *File) GetBase64() string {
+	return base64.StdEncoding.EncodeToString(f.bytes)
+}
+
+// GetReport returns the metrics.Report.
+func (f *File) GetReport() *metrics.Report {
+	return f.report
+}
+
+// Save saves the file.
+func (f *File) Save(file string) error {
+	return os.WriteFile(file, f.bytes, os.ModePerm)
+}
+
+// Merge returns ErrMergeNotSupported, as only PDFs can be merged.
+func (f *File) Merge([]byte) error {
+	return ErrMergeNotSupported
+}
@@ -0,0 +1,42 @@
+package core_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+)
+
+func TestNewFile(t *testing.T) {
+	// Act
+	sut := core.NewFile(nil, nil)
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*core.File", fmt.Sprintf("%T", sut))
+}
+
+func TestFile_GetBase64(t *testing.T) {
+	// Arrange
+	sut := core.NewFile([]byte{1, 2, 3}, nil)
+
+	// Act
+	b64 := sut.GetBase64()
+
+	// Assert
+	assert.Equal(t, "AQID", b64)
+}
+
+func TestFile_Merge(t *testing.T) {
+	// Arrange
+	sut := core.NewFile([]byte{1, 2, 3}, nil)
+
+	// Act
+	err := sut.Merge([]byte{4, 5, 6})
+
+	// Assert
+	assert.ErrorIs(t, err, core.ErrMergeNotSupported)
+	assert.Equal(t, []byte{1, 2, 3}, sut.GetBytes())
+}
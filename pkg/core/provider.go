@@ -1,6 +1,8 @@
 package core
 
 import (
+	"io"
+
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/extension"
 	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
 	"github.com/miguelbernadi/maroto/v2/pkg/props"
@@ -9,22 +11,36 @@ import (
 // Provider is the abstraction of a document creator provider.
 type Provider interface {
 	// Grid
+	AddPage(width, height float64)
 	CreateRow(height float64)
+	SetPosition(x, y float64)
 	CreateCol(width, height float64, config *entity.Config, prop *props.Cell)
 
 	// Features
 	AddLine(cell *entity.Cell, prop *props.Line)
 	AddText(text string, cell *entity.Cell, prop *props.Text)
 	GetTextHeight(prop *props.Font) float64
+	GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int
+	GetLines(text string, textProp *props.Text, colWidth float64) []string
+	GetStringWidth(text string, textProp *props.Text) float64
 	AddMatrixCode(code string, cell *entity.Cell, prop *props.Rect)
 	AddQrCode(code string, cell *entity.Cell, rect *props.Rect)
 	AddBarCode(code string, cell *entity.Cell, prop *props.Barcode)
 	AddImageFromFile(value string, cell *entity.Cell, prop *props.Rect)
 	AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type)
 	AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type)
+	AddTextWatermark(text string, cell *entity.Cell, prop *props.Watermark)
+	AddImageWatermark(bytes []byte, cell *entity.Cell, prop *props.Watermark, extension extension.Type)
+	GetDimensionsByImage(file string) (*entity.Dimensions, error)
+	GetDimensionsByImageByte(bytes []byte, extension extension.Type) (*entity.Dimensions, error)
+	GetDimensionsByQrCode(code string) (*entity.Dimensions, error)
+	GetDimensionsByMatrixCode(code string) (*entity.Dimensions, error)
+	AddBookmark(title string, level int)
+	AddDestination(name string)
 
 	// General
 	GenerateBytes() ([]byte, error)
+	GenerateTo(w io.Writer) error
 
 	SetProtection(protection *entity.Protection)
 	SetCompression(compression bool)
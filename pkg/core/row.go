@@ -0,0 +1,13 @@
+package core
+
+import "github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+
+// GetRowHeight returns the height of a Row. When the Row implements Measurable
+// and was created without a height, the height is measured from its content.
+func GetRowHeight(r Row, provider Provider, cell *entity.Cell) float64 {
+	if measurable, ok := r.(Measurable); ok && measurable.IsAutoHeight() {
+		return measurable.Measure(provider, cell)
+	}
+
+	return r.GetHeight()
+}
@@ -0,0 +1,63 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/internal/fixture"
+	"github.com/miguelbernadi/maroto/v2/mocks"
+	"github.com/miguelbernadi/maroto/v2/pkg/components/row"
+	"github.com/miguelbernadi/maroto/v2/pkg/core"
+	"github.com/miguelbernadi/maroto/v2/pkg/core/entity"
+)
+
+func TestGetRowHeight(t *testing.T) {
+	t.Run("when row is not measurable, should return the height of the row", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		r := &mocks.Row{}
+		r.EXPECT().GetHeight().Return(10.0)
+
+		// Act
+		height := core.GetRowHeight(r, provider, &cell)
+
+		// Assert
+		assert.Equal(t, 10.0, height)
+		r.AssertNumberOfCalls(t, "GetHeight", 1)
+	})
+	t.Run("when row has height, should return the height of the row", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		// Act
+		height := core.GetRowHeight(row.New(10), provider, &cell)
+
+		// Assert
+		assert.Equal(t, 10.0, height)
+	})
+	t.Run("when row has no height, should measure the content of the row", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{MaxGridSize: 12}
+		cell := fixture.CellEntity()
+		provider := &mocks.Provider{}
+
+		col := &mocks.Col{}
+		col.EXPECT().SetConfig(cfg)
+		col.EXPECT().GetSize().Return(12)
+		col.EXPECT().GetHeight(provider, &cell).Return(15.0)
+
+		r := row.New().Add(col)
+		r.SetConfig(cfg)
+
+		// Act
+		height := core.GetRowHeight(r, provider, &cell)
+
+		// Assert
+		assert.Equal(t, 15.0, height)
+		col.AssertNumberOfCalls(t, "GetHeight", 1)
+	})
+}
@@ -6,10 +6,32 @@ import (
 	"io"
 
 	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
 )
 
-// Bytes merges PDFs from byte slices.
+// outline is an entry of the PDF outline with the page that it points to
+// in the merged PDF.
+type outline struct {
+	title types.Object
+	page  int
+	view  types.Array
+	kids  []outline
+}
+
+// Bytes merges PDFs from byte slices. The outlines (bookmarks) of all PDFs
+// are kept, pointing to the same positions in the merged PDF.
 func Bytes(pdfs ...[]byte) ([]byte, error) {
+	return BytesWithOutlineLevels(nil, pdfs...)
+}
+
+// BytesWithOutlineLevels merges PDFs like Bytes, but the outline entries are nested
+// by the levels, which are the levels of all the entries of the PDFs in order. Then
+// the entries of a PDF can be nested in an entry of a previous PDF, e.g. when a
+// document is generated in parts. The levels are ignored when there is not one
+// level for each entry.
+func BytesWithOutlineLevels(levels []int, pdfs ...[]byte) ([]byte, error) {
 	readers := make([]io.ReadSeeker, len(pdfs))
 	for i, pdf := range pdfs {
 		readers[i] = bytes.NewReader(pdf)
@@ -21,11 +43,303 @@ func Bytes(pdfs ...[]byte) ([]byte, error) {
 		return nil, err
 	}
 
-	return buf.Bytes(), nil
+	if !mayHaveOutlines(pdfs) {
+		return buf.Bytes(), nil
+	}
+
+	outlines, err := readOutlines(pdfs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(outlines) == 0 {
+		return buf.Bytes(), nil
+	}
+
+	entries, entriesLevels := flattenOutlines(outlines, 0)
+	if len(levels) == len(entries) {
+		entriesLevels = levels
+	}
+
+	outlines, _ = nestOutlines(entries, entriesLevels, 0)
+	return writeOutlines(buf.Bytes(), outlines)
+}
+
+// mayHaveOutlines reports whether any of the PDFs may have outlines, without
+// parsing them. The outlines dictionary can be hidden in a compressed object
+// stream, so a PDF with object streams may have outlines too.
+func mayHaveOutlines(pdfs [][]byte) bool {
+	for _, pdf := range pdfs {
+		if bytes.Contains(pdf, []byte("/Outlines")) || bytes.Contains(pdf, []byte("/ObjStm")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flattenOutlines returns the entries of the outlines in order, without
+// their kids, and the level of each entry in the outlines.
+func flattenOutlines(outlines []outline, level int) ([]outline, []int) {
+	var entries []outline
+	var levels []int
+
+	for _, item := range outlines {
+		kids := item.kids
+		item.kids = nil
+		entries = append(entries, item)
+		levels = append(levels, level)
+
+		kidsEntries, kidsLevels := flattenOutlines(kids, level+1)
+		entries = append(entries, kidsEntries...)
+		levels = append(levels, kidsLevels...)
+	}
+
+	return entries, levels
+}
+
+// nestOutlines nests the entries from the level, until an entry with a smaller
+// level. An entry cannot be more than one level deeper than the previous entry,
+// so the deeper entries are nested in the previous entry. It returns the nested
+// entries and the quantity of entries used.
+func nestOutlines(entries []outline, levels []int, level int) ([]outline, int) {
+	var items []outline
+	i := 0
+
+	for i < len(entries) && (level == 0 || levels[i] >= level) {
+		item := entries[i]
+		i++
+
+		kids, used := nestOutlines(entries[i:], levels[i:], level+1)
+		item.kids = kids
+		items = append(items, item)
+		i += used
+	}
+
+	return items, i
 }
 
 func mergePdfs(readers []io.ReadSeeker, writer io.Writer, dividerPage bool) error {
+	conf := getConfiguration()
+	return api.MergeRaw(readers, writer, dividerPage, conf)
+}
+
+func getConfiguration() *model.Configuration {
 	conf := api.LoadConfiguration()
 	conf.WriteXRefStream = false
-	return api.MergeRaw(readers, writer, dividerPage, conf)
+	return conf
+}
+
+// readOutlines reads the outlines of all PDFs, with the pages offset by
+// the pages of the previous PDFs. It is needed because the merge only
+// keeps the outlines of the first PDF.
+func readOutlines(pdfs [][]byte) ([]outline, error) {
+	var outlines []outline
+	offset := 0
+
+	for _, pdf := range pdfs {
+		ctx, err := api.ReadContext(bytes.NewReader(pdf), getConfiguration())
+		if err != nil {
+			return nil, err
+		}
+
+		if err = ctx.EnsurePageCount(); err != nil {
+			return nil, err
+		}
+
+		rootDict, err := ctx.Catalog()
+		if err != nil {
+			return nil, err
+		}
+
+		outlinesDict, err := ctx.DereferenceDict(rootDict["Outlines"])
+		if err != nil {
+			return nil, err
+		}
+
+		if outlinesDict != nil {
+			items, err := readOutlineItems(ctx, outlinesDict.IndirectRefEntry("First"), offset)
+			if err != nil {
+				return nil, err
+			}
+			outlines = append(outlines, items...)
+		}
+
+		offset += ctx.PageCount
+	}
+
+	return outlines, nil
+}
+
+func readOutlineItems(ctx *model.Context, ir *types.IndirectRef, offset int) ([]outline, error) {
+	var items []outline
+
+	for ir != nil {
+		d, err := ctx.DereferenceDict(*ir)
+		if err != nil {
+			return nil, err
+		}
+
+		kids, err := readOutlineItems(ctx, d.IndirectRefEntry("First"), offset)
+		if err != nil {
+			return nil, err
+		}
+
+		item, ok, err := readOutlineItem(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			item.page += offset
+			item.kids = kids
+			items = append(items, item)
+		} else {
+			items = append(items, kids...)
+		}
+
+		ir = d.IndirectRefEntry("Next")
+	}
+
+	return items, nil
+}
+
+func readOutlineItem(ctx *model.Context, d types.Dict) (outline, bool, error) {
+	title, err := ctx.Dereference(d["Title"])
+	if err != nil {
+		return outline{}, false, err
+	}
+
+	dest, err := ctx.Dereference(d["Dest"])
+	if err != nil {
+		return outline{}, false, err
+	}
+
+	if dest == nil {
+		action, err := ctx.DereferenceDict(d["A"])
+		if err != nil {
+			return outline{}, false, err
+		}
+
+		if action == nil || action.NameEntry("S") == nil || *action.NameEntry("S") != "GoTo" {
+			return outline{}, false, nil
+		}
+
+		if dest, err = ctx.Dereference(action["D"]); err != nil {
+			return outline{}, false, err
+		}
+	}
+
+	if dest == nil {
+		return outline{}, false, nil
+	}
+
+	pageRef, err := pdfcpu.PageObjFromDestination(ctx, dest)
+	if err != nil || pageRef == nil {
+		return outline{}, false, err
+	}
+
+	page, err := ctx.PageNumber(pageRef.ObjectNumber.Value())
+	if err != nil {
+		return outline{}, false, err
+	}
+
+	view := types.Array{types.Name("Fit")}
+	if arr, ok := dest.(types.Array); ok && len(arr) > 1 {
+		view = arr[1:]
+	}
+
+	return outline{title: title, page: page, view: view}, true, nil
+}
+
+// writeOutlines replaces the outlines of a PDF.
+func writeOutlines(pdf []byte, outlines []outline) ([]byte, error) {
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), getConfiguration())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EnsurePageCount(); err != nil {
+		return nil, err
+	}
+
+	rootDict, err := ctx.Catalog()
+	if err != nil {
+		return nil, err
+	}
+
+	outlinesDict := types.Dict{"Type": types.Name("Outlines")}
+	outlinesRef, err := ctx.IndRefForNewObject(outlinesDict)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last, count, err := writeOutlineItems(ctx, outlines, *outlinesRef)
+	if err != nil {
+		return nil, err
+	}
+
+	outlinesDict["First"] = *first
+	outlinesDict["Last"] = *last
+	outlinesDict["Count"] = types.Integer(count)
+	rootDict["Outlines"] = *outlinesRef
+
+	var buf bytes.Buffer
+	if err = api.WriteContext(ctx, &buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeOutlineItems(ctx *model.Context, outlines []outline, parent types.IndirectRef) (
+	first *types.IndirectRef, last *types.IndirectRef, count int, err error,
+) {
+	var prev types.Dict
+
+	for _, item := range outlines {
+		_, pageRef, _, err := ctx.PageDict(item.page, false)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		dest := append(types.Array{*pageRef}, item.view...)
+		d := types.Dict{
+			"Title":  item.title,
+			"Parent": parent,
+			"Dest":   dest,
+		}
+
+		ir, err := ctx.IndRefForNewObject(d)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		if len(item.kids) > 0 {
+			kidsFirst, kidsLast, kidsCount, err := writeOutlineItems(ctx, item.kids, *ir)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+
+			d["First"] = *kidsFirst
+			d["Last"] = *kidsLast
+			d["Count"] = types.Integer(kidsCount)
+			count += kidsCount
+		}
+
+		if first == nil {
+			first = ir
+		}
+
+		if prev != nil {
+			prev["Next"] = *ir
+			d["Prev"] = *last
+		}
+
+		prev = d
+		last = ir
+		count++
+	}
+
+	return first, last, count, nil
 }
@@ -1,8 +1,10 @@
 package merge_test
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/pdfcpu/pdfcpu/pkg/api"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/miguelbernadi/maroto/v2"
@@ -23,9 +25,104 @@ func TestBytes(t *testing.T) {
 	doc2Bytes := doc2.GetBytes()
 
 	// Act
-	bytes, err := merge.Bytes(doc1Bytes, doc2Bytes)
+	merged, err := merge.Bytes(doc1Bytes, doc2Bytes)
 
 	// Assert
 	assert.Nil(t, err)
-	assert.InDelta(t, len(doc1Bytes)+len(doc2Bytes), len(bytes), 500)
+	assert.InDelta(t, len(doc1Bytes)+len(doc2Bytes), len(merged), 500)
+}
+
+func TestBytes_WhenDocumentsDoNotHaveBookmarks_ShouldNotAddBookmarks(t *testing.T) {
+	// Arrange
+	m1 := maroto.New()
+	m1.AddRows(text.NewRow(10, "text1"))
+	doc1, _ := m1.Generate()
+
+	m2 := maroto.New()
+	m2.AddRows(text.NewRow(10, "text2"))
+	doc2, _ := m2.Generate()
+
+	// Act
+	merged, err := merge.Bytes(doc1.GetBytes(), doc2.GetBytes())
+
+	// Assert
+	assert.Nil(t, err)
+	bookmarks, _ := api.Bookmarks(bytes.NewReader(merged), api.LoadConfiguration())
+	assert.Empty(t, bookmarks)
+}
+
+func TestBytes_WhenDocumentsHaveBookmarks_ShouldKeepAllBookmarks(t *testing.T) {
+	// Arrange
+	m1 := maroto.New()
+	m1.AddRows(text.NewRow(10, "chapter1").WithBookmark("chapter1", 0))
+	m1.AddRows(text.NewRow(10, "section1").WithBookmark("section1", 1))
+	doc1, _ := m1.Generate()
+
+	m2 := maroto.New()
+	m2.AddRows(text.NewRow(10, "chapter2").WithBookmark("chapter2", 0))
+	doc2, _ := m2.Generate()
+
+	// Act
+	merged, err := merge.Bytes(doc1.GetBytes(), doc2.GetBytes())
+
+	// Assert
+	assert.Nil(t, err)
+	bookmarks, err := api.Bookmarks(bytes.NewReader(merged), api.LoadConfiguration())
+	assert.Nil(t, err)
+	assert.Len(t, bookmarks, 2)
+	assert.Equal(t, "chapter1", bookmarks[0].Title)
+	assert.Equal(t, 1, bookmarks[0].PageFrom)
+	assert.Len(t, bookmarks[0].Kids, 1)
+	assert.Equal(t, "section1", bookmarks[0].Kids[0].Title)
+	assert.Equal(t, "chapter2", bookmarks[1].Title)
+	assert.Equal(t, 2, bookmarks[1].PageFrom)
+}
+
+func TestBytesWithOutlineLevels(t *testing.T) {
+	t.Run("when levels continue a bookmark of a previous document, should nest the bookmarks", func(t *testing.T) {
+		// Arrange
+		m1 := maroto.New()
+		m1.AddRows(text.NewRow(10, "chapter1").WithBookmark("chapter1", 0))
+		doc1, _ := m1.Generate()
+
+		m2 := maroto.New()
+		m2.AddRows(text.NewRow(10, "section1").WithBookmark("section1", 1))
+		m2.AddRows(text.NewRow(10, "chapter2").WithBookmark("chapter2", 0))
+		doc2, _ := m2.Generate()
+
+		// Act
+		merged, err := merge.BytesWithOutlineLevels([]int{0, 1, 0}, doc1.GetBytes(), doc2.GetBytes())
+
+		// Assert
+		assert.Nil(t, err)
+		bookmarks, err := api.Bookmarks(bytes.NewReader(merged), api.LoadConfiguration())
+		assert.Nil(t, err)
+		assert.Len(t, bookmarks, 2)
+		assert.Equal(t, "chapter1", bookmarks[0].Title)
+		assert.Len(t, bookmarks[0].Kids, 1)
+		assert.Equal(t, "section1", bookmarks[0].Kids[0].Title)
+		assert.Equal(t, 2, bookmarks[0].Kids[0].PageFrom)
+		assert.Equal(t, "chapter2", bookmarks[1].Title)
+	})
+	t.Run("when there is not one level for each bookmark, should keep the nesting of the documents", func(t *testing.T) {
+		// Arrange
+		m1 := maroto.New()
+		m1.AddRows(text.NewRow(10, "chapter1").WithBookmark("chapter1", 0))
+		doc1, _ := m1.Generate()
+
+		m2 := maroto.New()
+		m2.AddRows(text.NewRow(10, "section1").WithBookmark("section1", 1))
+		doc2, _ := m2.Generate()
+
+		// Act
+		merged, err := merge.BytesWithOutlineLevels([]int{0}, doc1.GetBytes(), doc2.GetBytes())
+
+		// Assert
+		assert.Nil(t, err)
+		bookmarks, err := api.Bookmarks(bytes.NewReader(merged), api.LoadConfiguration())
+		assert.Nil(t, err)
+		assert.Len(t, bookmarks, 2)
+		assert.Empty(t, bookmarks[0].Kids)
+		assert.Equal(t, "section1", bookmarks[1].Title)
+	})
 }
@@ -12,6 +12,12 @@ type Cell struct {
 	BorderType      border.Type
 	BorderThickness float64
 	LineStyle       linestyle.Type
+	// PaddingLeft, PaddingTop, PaddingRight and PaddingBottom are the spaces between
+	// the borders of the cell and its content.
+	PaddingLeft   float64
+	PaddingTop    float64
+	PaddingRight  float64
+	PaddingBottom float64
 }
 
 // ToMap adds the Cell fields to the map.
@@ -42,5 +48,30 @@ func (c *Cell) ToMap() map[string]interface{} {
 		m["prop_border_color"] = c.BorderColor.ToString()
 	}
 
+	if c.PaddingLeft != 0 {
+		m["prop_padding_left"] = c.PaddingLeft
+	}
+
+	if c.PaddingTop != 0 {
+		m["prop_padding_top"] = c.PaddingTop
+	}
+
+	if c.PaddingRight != 0 {
+		m["prop_padding_right"] = c.PaddingRight
+	}
+
+	if c.PaddingBottom != 0 {
+		m["prop_padding_bottom"] = c.PaddingBottom
+	}
+
 	return m
 }
+
+// GetVerticalPadding returns the sum of the top and bottom paddings of the Cell.
+func (c *Cell) GetVerticalPadding() float64 {
+	if c == nil {
+		return 0
+	}
+
+	return c.PaddingTop + c.PaddingBottom
+}
@@ -36,4 +36,40 @@ func TestCell_ToMap(t *testing.T) {
 		assert.Equal(t, "RGB(255, 100, 50)", m["prop_background_color"])
 		assert.Equal(t, "RGB(200, 80, 60)", m["prop_border_color"])
 	})
+	t.Run("when cell has padding, should return map with padding", func(t *testing.T) {
+		// Arrange
+		sut := props.Cell{PaddingLeft: 1, PaddingTop: 2, PaddingRight: 3, PaddingBottom: 4}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 1.0, m["prop_padding_left"])
+		assert.Equal(t, 2.0, m["prop_padding_top"])
+		assert.Equal(t, 3.0, m["prop_padding_right"])
+		assert.Equal(t, 4.0, m["prop_padding_bottom"])
+	})
+}
+
+func TestCell_GetVerticalPadding(t *testing.T) {
+	t.Run("when cell is nil, should return 0", func(t *testing.T) {
+		// Arrange
+		var sut *props.Cell
+
+		// Act
+		padding := sut.GetVerticalPadding()
+
+		// Assert
+		assert.Equal(t, 0.0, padding)
+	})
+	t.Run("when cell has padding, should return the sum of top and bottom", func(t *testing.T) {
+		// Arrange
+		sut := props.Cell{PaddingLeft: 1, PaddingTop: 2, PaddingRight: 3, PaddingBottom: 4}
+
+		// Act
+		padding := sut.GetVerticalPadding()
+
+		// Assert
+		assert.Equal(t, 6.0, padding)
+	})
 }
@@ -0,0 +1,41 @@
+package props
+
+// List represents properties from the rows of a list.
+type List struct {
+	// MinRowsBefore is the minimum quantity of content rows placed with the header before
+	// a page break, so the header is not left alone at the end of a page.
+	MinRowsBefore int
+	// MinRowsAfter is the minimum quantity of content rows placed in a new page
+	// after a page break, so the last rows of the list are not left alone in a page.
+	MinRowsAfter int
+}
+
+// ToMap returns a map with the List fields.
+func (l *List) ToMap() map[string]interface{} {
+	if l == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if l.MinRowsBefore != 0 {
+		m["prop_min_rows_before"] = l.MinRowsBefore
+	}
+
+	if l.MinRowsAfter != 0 {
+		m["prop_min_rows_after"] = l.MinRowsAfter
+	}
+
+	return m
+}
+
+// MakeValid from List define default values for a List.
+func (l *List) MakeValid() {
+	if l.MinRowsBefore < 0 {
+		l.MinRowsBefore = 0
+	}
+
+	if l.MinRowsAfter < 0 {
+		l.MinRowsAfter = 0
+	}
+}
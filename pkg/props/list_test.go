@@ -0,0 +1,45 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+func TestList_ToMap(t *testing.T) {
+	t.Run("when list is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var prop *props.List
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when list is filled, should return the map", func(t *testing.T) {
+		// Arrange
+		prop := props.List{MinRowsBefore: 2, MinRowsAfter: 3}
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Equal(t, 2, m["prop_min_rows_before"])
+		assert.Equal(t, 3, m["prop_min_rows_after"])
+	})
+}
+
+func TestList_MakeValid(t *testing.T) {
+	// Arrange
+	prop := props.List{MinRowsBefore: -1, MinRowsAfter: -2}
+
+	// Act
+	prop.MakeValid()
+
+	// Assert
+	assert.Equal(t, 0, prop.MinRowsBefore)
+	assert.Equal(t, 0, prop.MinRowsAfter)
+}
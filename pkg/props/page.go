@@ -7,6 +7,8 @@ import (
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/breakline"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontstyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/orientation"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagesize"
 )
 
 // Place is the representation of a place in a page.
@@ -41,6 +43,27 @@ type Page struct {
 	Style   fontstyle.Type
 	Size    float64
 	Color   *Color
+	// PageSize is the size of the page, which overrides the size of the document.
+	PageSize pagesize.Type
+	// Orientation is the orientation of the page, which overrides the orientation of the document.
+	Orientation orientation.Type
+}
+
+// GetDimensions returns the width and height of the page. When the page has no
+// size and orientation, the default width and height of the document are used.
+func (p *Page) GetDimensions(defaultWidth, defaultHeight float64) (float64, float64) {
+	width, height := defaultWidth, defaultHeight
+	if p.PageSize != "" {
+		width, height = pagesize.GetDimensions(p.PageSize)
+	}
+
+	horizontal := p.Orientation == orientation.Horizontal && height > width
+	vertical := p.Orientation == orientation.Vertical && width > height
+	if horizontal || vertical {
+		width, height = height, width
+	}
+
+	return width, height
 }
 
 // GetNumberTextProp returns the Text properties of the page number.
@@ -68,8 +91,17 @@ func (p *Page) GetNumberTextProp(height float64) *Text {
 	return text
 }
 
-// GetPageString returns the page string.
+// GetPageString returns the page string, where the whole document is the section of the page.
 func (p *Page) GetPageString(current, total int) string {
+	return p.GetSectionPageString(current, total, fmt.Sprintf("%d", current), total)
+}
+
+// GetSectionPageString returns the page string, where {section} is the number of
+// the page in its section, written as label, and {section_total} is the quantity
+// of pages of the section.
+func (p *Page) GetSectionPageString(current, total int, label string, sectionTotal int) string {
 	pattern := strings.ReplaceAll(p.Pattern, "{current}", fmt.Sprintf("%d", current))
-	return strings.ReplaceAll(pattern, "{total}", fmt.Sprintf("%d", total))
+	pattern = strings.ReplaceAll(pattern, "{total}", fmt.Sprintf("%d", total))
+	pattern = strings.ReplaceAll(pattern, "{section}", label)
+	return strings.ReplaceAll(pattern, "{section_total}", fmt.Sprintf("%d", sectionTotal))
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/breakline"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontfamily"
 	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontstyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/orientation"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/pagesize"
 	"github.com/miguelbernadi/maroto/v2/pkg/props"
 )
 
@@ -219,3 +221,73 @@ func TestPage_GetPageString(t *testing.T) {
 	// Assert
 	assert.Equal(t, "10 / 101", s)
 }
+
+func TestPage_GetSectionPageString(t *testing.T) {
+	t.Run("when pattern has section placeholders, should replace them", func(t *testing.T) {
+		// Arrange
+		prop := props.Page{Pattern: "{section} of {section_total} ({current} / {total})"}
+
+		// Act
+		s := prop.GetSectionPageString(10, 101, "iii", 4)
+
+		// Assert
+		assert.Equal(t, "iii of 4 (10 / 101)", s)
+	})
+	t.Run("when section is not defined, should use the document as section", func(t *testing.T) {
+		// Arrange
+		prop := props.Page{Pattern: "{section} of {section_total}"}
+
+		// Act
+		s := prop.GetPageString(10, 101)
+
+		// Assert
+		assert.Equal(t, "10 of 101", s)
+	})
+}
+
+func TestPage_GetDimensions(t *testing.T) {
+	t.Run("when size and orientation are not defined, should return default dimensions", func(t *testing.T) {
+		// Arrange
+		prop := props.Page{}
+
+		// Act
+		width, height := prop.GetDimensions(210, 297)
+
+		// Assert
+		assert.Equal(t, 210.0, width)
+		assert.Equal(t, 297.0, height)
+	})
+	t.Run("when size is defined, should return dimensions of size", func(t *testing.T) {
+		// Arrange
+		prop := props.Page{PageSize: pagesize.A5}
+
+		// Act
+		width, height := prop.GetDimensions(210, 297)
+
+		// Assert
+		assert.Equal(t, 148.4, width)
+		assert.Equal(t, 210.0, height)
+	})
+	t.Run("when orientation is horizontal, should return landscape dimensions", func(t *testing.T) {
+		// Arrange
+		prop := props.Page{Orientation: orientation.Horizontal}
+
+		// Act
+		width, height := prop.GetDimensions(210, 297)
+
+		// Assert
+		assert.Equal(t, 297.0, width)
+		assert.Equal(t, 210.0, height)
+	})
+	t.Run("when orientation is vertical and default is landscape, should return portrait dimensions", func(t *testing.T) {
+		// Arrange
+		prop := props.Page{PageSize: pagesize.A4, Orientation: orientation.Vertical}
+
+		// Act
+		width, height := prop.GetDimensions(297, 210)
+
+		// Assert
+		assert.Equal(t, 210.0, width)
+		assert.Equal(t, 297.0, height)
+	})
+}
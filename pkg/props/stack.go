@@ -0,0 +1,41 @@
+package props
+
+import "github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+
+// Stack represents properties from a col which stacks its components vertically.
+type Stack struct {
+	// Spacing is the space between two stacked components.
+	Spacing float64
+	// VerticalAlign define where the stacked components would be placed inside the cell (top, middle or bottom).
+	VerticalAlign align.Type
+}
+
+// ToMap returns a map with the Stack fields.
+func (s *Stack) ToMap() map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if s.Spacing != 0 {
+		m["prop_spacing"] = s.Spacing
+	}
+
+	if s.VerticalAlign != "" {
+		m["prop_vertical_align"] = s.VerticalAlign
+	}
+
+	return m
+}
+
+// MakeValid from Stack define default values for a Stack.
+func (s *Stack) MakeValid() {
+	if s.Spacing < 0 {
+		s.Spacing = 0
+	}
+
+	if s.VerticalAlign != align.Middle && s.VerticalAlign != align.Bottom {
+		s.VerticalAlign = align.Top
+	}
+}
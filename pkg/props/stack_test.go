@@ -0,0 +1,67 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+func TestStack_ToMap(t *testing.T) {
+	t.Run("when stack is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var prop *props.Stack
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when stack is filled, should return the map", func(t *testing.T) {
+		// Arrange
+		prop := props.Stack{Spacing: 2, VerticalAlign: align.Middle}
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Equal(t, 2.0, m["prop_spacing"])
+		assert.Equal(t, align.Middle, m["prop_vertical_align"])
+	})
+}
+
+func TestStack_MakeValid(t *testing.T) {
+	t.Run("when spacing is less than 0, should become 0", func(t *testing.T) {
+		// Arrange
+		prop := props.Stack{Spacing: -2}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, prop.Spacing)
+	})
+	t.Run("when vertical align is not defined, should become top", func(t *testing.T) {
+		// Arrange
+		prop := props.Stack{}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, align.Top, prop.VerticalAlign)
+	})
+	t.Run("when vertical align is bottom, should keep it", func(t *testing.T) {
+		// Arrange
+		prop := props.Stack{VerticalAlign: align.Bottom}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, align.Bottom, prop.VerticalAlign)
+	})
+}
@@ -0,0 +1,26 @@
+package props
+
+// Table represents properties from a Table.
+type Table struct {
+	// HeaderStyle define the style of the header row.
+	HeaderStyle *Cell
+	// HeaderProp define the text properties of the titles, the align is defined by each column.
+	HeaderProp Text
+	// ContentProp define the text properties of the content, the align is defined by each column.
+	ContentProp Text
+	// AlternatedStyle define the style of every other content row, creating a zebra striping.
+	AlternatedStyle *Cell
+	// TotalStyle define the style of the totals row.
+	TotalStyle *Cell
+	// TotalProp define the text properties of the totals, the align is defined by each column.
+	TotalProp Text
+	// RowHeight define a fixed height to every row, when it is not defined the rows grow to fit their content.
+	RowHeight float64
+}
+
+// MakeValid from Table define default values for a Table.
+func (t *Table) MakeValid() {
+	if t.RowHeight < 0 {
+		t.RowHeight = 0
+	}
+}
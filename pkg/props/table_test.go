@@ -0,0 +1,32 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+func TestTable_MakeValid(t *testing.T) {
+	t.Run("when row height is negative, should use auto height", func(t *testing.T) {
+		// Arrange
+		sut := props.Table{RowHeight: -5}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, sut.RowHeight)
+	})
+	t.Run("when row height is positive, should keep it", func(t *testing.T) {
+		// Arrange
+		sut := props.Table{RowHeight: 5}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 5.0, sut.RowHeight)
+	})
+}
@@ -23,6 +23,10 @@ type Text struct {
 	Size float64
 	// Align of the text.
 	Align align.Type
+	// VerticalAlign define where the text would be placed vertically inside the cell,
+	// align.Middle and align.Bottom measure the lines of the text to center it or to
+	// place it at the end of the cell. Other values place the text at the top.
+	VerticalAlign align.Type
 	// BreakLineStrategy define the break line strategy.
 	BreakLineStrategy breakline.Strategy
 	// VerticalPadding define an additional space between linet.
@@ -31,6 +35,9 @@ type Text struct {
 	Color *Color
 	// Hyperlink define a link to be opened when the text is clicked.
 	Hyperlink *string
+	// InternalLink define the name of a destination of the document to jump
+	// to when the text is clicked, the destination is defined by row.WithDestination.
+	InternalLink *string
 }
 
 // ToMap converts a Text to a map.
@@ -64,6 +71,10 @@ func (t *Text) ToMap() map[string]interface{} {
 		m["prop_align"] = t.Align
 	}
 
+	if t.VerticalAlign != "" {
+		m["prop_vertical_align"] = t.VerticalAlign
+	}
+
 	if t.BreakLineStrategy != "" {
 		m["prop_breakline_strategy"] = t.BreakLineStrategy
 	}
@@ -80,6 +91,10 @@ func (t *Text) ToMap() map[string]interface{} {
 		m["prop_hyperlink"] = *t.Hyperlink
 	}
 
+	if t.InternalLink != nil {
+		m["prop_internal_link"] = *t.InternalLink
+	}
+
 	return m
 }
 
@@ -128,3 +143,23 @@ func (t *Text) MakeValid(font *Font) {
 		t.BreakLineStrategy = breakline.EmptySpaceStrategy
 	}
 }
+
+// GetVerticalOffset returns the space between the Top of the Text and the first
+// line, which places a text with the quantity of lines in the VerticalAlign.
+func (t *Text) GetVerticalOffset(cellHeight, fontHeight float64, linesQuantity int) float64 {
+	if t.VerticalAlign != align.Middle && t.VerticalAlign != align.Bottom {
+		return 0
+	}
+
+	textHeight := float64(linesQuantity)*fontHeight + float64(linesQuantity-1)*t.VerticalPadding
+	space := cellHeight - t.Top - textHeight
+	if space <= 0 {
+		return 0
+	}
+
+	if t.VerticalAlign == align.Middle {
+		return space / 2
+	}
+
+	return space
+}
@@ -96,3 +96,46 @@ func TestText_MakeValid(t *testing.T) {
 		c.assert(t, c.fontProp)
 	}
 }
+
+func TestText_GetVerticalOffset(t *testing.T) {
+	t.Run("when vertical align is not defined, should return 0", func(t *testing.T) {
+		// Arrange
+		prop := props.Text{}
+
+		// Act
+		offset := prop.GetVerticalOffset(50, 5, 2)
+
+		// Assert
+		assert.Equal(t, 0.0, offset)
+	})
+	t.Run("when vertical align is middle, should return half of the free space", func(t *testing.T) {
+		// Arrange
+		prop := props.Text{VerticalAlign: align.Middle, VerticalPadding: 2, Top: 4}
+
+		// Act
+		offset := prop.GetVerticalOffset(50, 5, 2)
+
+		// Assert
+		assert.Equal(t, 17.0, offset)
+	})
+	t.Run("when vertical align is bottom, should return all the free space", func(t *testing.T) {
+		// Arrange
+		prop := props.Text{VerticalAlign: align.Bottom, VerticalPadding: 2, Top: 4}
+
+		// Act
+		offset := prop.GetVerticalOffset(50, 5, 2)
+
+		// Assert
+		assert.Equal(t, 34.0, offset)
+	})
+	t.Run("when text is higher than the cell, should return 0", func(t *testing.T) {
+		// Arrange
+		prop := props.Text{VerticalAlign: align.Bottom}
+
+		// Act
+		offset := prop.GetVerticalOffset(8, 5, 2)
+
+		// Assert
+		assert.Equal(t, 0.0, offset)
+	})
+}
@@ -0,0 +1,40 @@
+package props
+
+// TOC represents properties from a table of contents.
+type TOC struct {
+	// TitleProp define the text properties of the title.
+	TitleProp Text
+	// EntryProp define the text properties of the entries, the title of an entry
+	// is always aligned to the left and its page number to the right.
+	EntryProp Text
+	// Indent define the space added to the left of an entry for each level.
+	Indent float64
+	// Leader define the text repeated between the title and the page number of an entry.
+	Leader string
+}
+
+// ToMap converts a TOC to a map.
+func (t *TOC) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if t.Indent != 0 {
+		m["prop_indent"] = t.Indent
+	}
+
+	if t.Leader != "" {
+		m["prop_leader"] = t.Leader
+	}
+
+	return m
+}
+
+// MakeValid from TOC define default values for a TOC.
+func (t *TOC) MakeValid() {
+	if t.Indent <= 0 {
+		t.Indent = 5
+	}
+
+	if t.Leader == "" {
+		t.Leader = "."
+	}
+}
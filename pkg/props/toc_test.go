@@ -0,0 +1,52 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+func TestTOC_ToMap(t *testing.T) {
+	// Arrange
+	sut := props.TOC{
+		Indent: 3,
+		Leader: "-",
+	}
+
+	// Act
+	m := sut.ToMap()
+
+	// Assert
+	assert.Equal(t, 3.0, m["prop_indent"])
+	assert.Equal(t, "-", m["prop_leader"])
+}
+
+func TestTOC_MakeValid(t *testing.T) {
+	t.Run("when values are not defined, should use default", func(t *testing.T) {
+		// Arrange
+		sut := props.TOC{}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 5.0, sut.Indent)
+		assert.Equal(t, ".", sut.Leader)
+	})
+	t.Run("when values are defined, should keep them", func(t *testing.T) {
+		// Arrange
+		sut := props.TOC{
+			Indent: 3,
+			Leader: "-",
+		}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 3.0, sut.Indent)
+		assert.Equal(t, "-", sut.Leader)
+	})
+}
@@ -0,0 +1,137 @@
+package props
+
+import (
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/breakline"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontstyle"
+)
+
+const (
+	// DefaultWatermarkOpacity is the opacity of a watermark when it is not defined.
+	DefaultWatermarkOpacity = 0.3
+	// DefaultWatermarkSize is the font size of a text watermark when it is not defined.
+	DefaultWatermarkSize = 80.0
+)
+
+// Watermark represents properties from a text or an image drawn on the pages,
+// e.g. a "DRAFT" stamp.
+type Watermark struct {
+	// Angle is the rotation of the watermark in degrees, counter-clockwise.
+	Angle float64
+	// Opacity is the opacity of the watermark, from 0 (transparent) to 1 (opaque).
+	Opacity float64
+	// Family of the text watermark, ex: consts.Arial, helvetica and etc.
+	Family string
+	// Style of the text watermark, ex: consts.Normal, bold and etc.
+	Style fontstyle.Type
+	// Size of the text watermark.
+	Size float64
+	// Color define the font color of the text watermark.
+	Color *Color
+	// Percent is how much the image watermark will occupy the page.
+	Percent float64
+	// Foreground define that the watermark is drawn above the content, instead of below it.
+	Foreground bool
+	// Pages are the numbers of the pages with the watermark, when empty every page has it.
+	Pages []int
+}
+
+// ToMap from Watermark will return a map representation from Watermark.
+func (w *Watermark) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if w.Angle != 0 {
+		m["prop_angle"] = w.Angle
+	}
+
+	if w.Opacity != 0 {
+		m["prop_opacity"] = w.Opacity
+	}
+
+	if w.Family != "" {
+		m["prop_font_family"] = w.Family
+	}
+
+	if w.Style != "" {
+		m["prop_font_style"] = w.Style
+	}
+
+	if w.Size != 0 {
+		m["prop_font_size"] = w.Size
+	}
+
+	if w.Color != nil {
+		m["prop_color"] = w.Color.ToString()
+	}
+
+	if w.Percent != 0 {
+		m["prop_percent"] = w.Percent
+	}
+
+	if w.Foreground {
+		m["prop_foreground"] = w.Foreground
+	}
+
+	if len(w.Pages) > 0 {
+		m["prop_pages"] = w.Pages
+	}
+
+	return m
+}
+
+// MakeValid from Watermark define default values for a Watermark,
+// where the font of the text watermark is the default font.
+func (w *Watermark) MakeValid(defaultFont *Font) {
+	if w.Opacity <= 0 || w.Opacity > 1 {
+		w.Opacity = DefaultWatermarkOpacity
+	}
+
+	if w.Percent <= 0 || w.Percent > 100 {
+		w.Percent = 100
+	}
+
+	if w.Size <= 0 {
+		w.Size = DefaultWatermarkSize
+	}
+
+	if w.Family == "" {
+		w.Family = defaultFont.Family
+	}
+
+	if w.Style == "" {
+		w.Style = defaultFont.Style
+	}
+
+	if w.Color == nil {
+		w.Color = defaultFont.Color
+	}
+}
+
+// HasPage returns true when the watermark is drawn in the page with the number.
+func (w *Watermark) HasPage(number int) bool {
+	if len(w.Pages) == 0 {
+		return true
+	}
+
+	for _, page := range w.Pages {
+		if page == number {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToTextProp returns the Text properties of the text watermark,
+// which is centered in the cell.
+func (w *Watermark) ToTextProp() *Text {
+	return &Text{
+		Family:            w.Family,
+		Style:             w.Style,
+		Size:              w.Size,
+		Color:             w.Color,
+		Align:             align.Center,
+		VerticalAlign:     align.Middle,
+		BreakLineStrategy: breakline.EmptySpaceStrategy,
+	}
+}
@@ -0,0 +1,160 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/align"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/breakline"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontfamily"
+	"github.com/miguelbernadi/maroto/v2/pkg/consts/fontstyle"
+	"github.com/miguelbernadi/maroto/v2/pkg/props"
+)
+
+func TestWatermark_ToMap(t *testing.T) {
+	t.Run("when watermark is empty, should return an empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when watermark is filled, should map all fields", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{
+			Angle:      45,
+			Opacity:    0.5,
+			Family:     fontfamily.Courier,
+			Style:      fontstyle.Bold,
+			Size:       60,
+			Color:      &props.RedColor,
+			Percent:    80,
+			Foreground: true,
+			Pages:      []int{1, 3},
+		}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 45.0, m["prop_angle"])
+		assert.Equal(t, 0.5, m["prop_opacity"])
+		assert.Equal(t, fontfamily.Courier, m["prop_font_family"])
+		assert.Equal(t, fontstyle.Bold, m["prop_font_style"])
+		assert.Equal(t, 60.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_color"])
+		assert.Equal(t, 80.0, m["prop_percent"])
+		assert.Equal(t, true, m["prop_foreground"])
+		assert.Equal(t, []int{1, 3}, m["prop_pages"])
+	})
+}
+
+func TestWatermark_MakeValid(t *testing.T) {
+	defaultFont := &props.Font{
+		Family: fontfamily.Arial,
+		Style:  fontstyle.Normal,
+		Size:   10,
+		Color:  &props.BlackColor,
+	}
+
+	t.Run("when watermark is empty, should apply the defaults", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{}
+
+		// Act
+		sut.MakeValid(defaultFont)
+
+		// Assert
+		assert.Equal(t, props.DefaultWatermarkOpacity, sut.Opacity)
+		assert.Equal(t, 100.0, sut.Percent)
+		assert.Equal(t, props.DefaultWatermarkSize, sut.Size)
+		assert.Equal(t, fontfamily.Arial, sut.Family)
+		assert.Equal(t, fontstyle.Normal, sut.Style)
+		assert.Equal(t, &props.BlackColor, sut.Color)
+	})
+	t.Run("when opacity and percent are out of range, should apply the defaults", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{
+			Opacity: 1.5,
+			Percent: 120,
+			Size:    -1,
+		}
+
+		// Act
+		sut.MakeValid(defaultFont)
+
+		// Assert
+		assert.Equal(t, props.DefaultWatermarkOpacity, sut.Opacity)
+		assert.Equal(t, 100.0, sut.Percent)
+		assert.Equal(t, props.DefaultWatermarkSize, sut.Size)
+	})
+	t.Run("when watermark is valid, should not change it", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{
+			Opacity: 0.5,
+			Percent: 50,
+			Size:    40,
+			Family:  fontfamily.Courier,
+			Style:   fontstyle.Italic,
+			Color:   &props.RedColor,
+		}
+
+		// Act
+		sut.MakeValid(defaultFont)
+
+		// Assert
+		assert.Equal(t, 0.5, sut.Opacity)
+		assert.Equal(t, 50.0, sut.Percent)
+		assert.Equal(t, 40.0, sut.Size)
+		assert.Equal(t, fontfamily.Courier, sut.Family)
+		assert.Equal(t, fontstyle.Italic, sut.Style)
+		assert.Equal(t, &props.RedColor, sut.Color)
+	})
+}
+
+func TestWatermark_HasPage(t *testing.T) {
+	t.Run("when pages are empty, should have every page", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{}
+
+		// Act & Assert
+		assert.True(t, sut.HasPage(1))
+		assert.True(t, sut.HasPage(10))
+	})
+	t.Run("when pages are defined, should have only the pages", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{Pages: []int{2, 4}}
+
+		// Act & Assert
+		assert.False(t, sut.HasPage(1))
+		assert.True(t, sut.HasPage(2))
+		assert.False(t, sut.HasPage(3))
+		assert.True(t, sut.HasPage(4))
+	})
+}
+
+func TestWatermark_ToTextProp(t *testing.T) {
+	// Arrange
+	sut := props.Watermark{
+		Family: fontfamily.Courier,
+		Style:  fontstyle.Bold,
+		Size:   60,
+		Color:  &props.RedColor,
+	}
+
+	// Act
+	prop := sut.ToTextProp()
+
+	// Assert
+	assert.Equal(t, fontfamily.Courier, prop.Family)
+	assert.Equal(t, fontstyle.Bold, prop.Style)
+	assert.Equal(t, 60.0, prop.Size)
+	assert.Equal(t, &props.RedColor, prop.Color)
+	assert.Equal(t, align.Center, prop.Align)
+	assert.Equal(t, align.Middle, prop.VerticalAlign)
+	assert.Equal(t, breakline.EmptySpaceStrategy, prop.BreakLineStrategy)
+}